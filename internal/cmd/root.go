@@ -14,6 +14,7 @@ import (
 	"github.com/charmbracelet/fang"
 	"github.com/spf13/cobra"
 
+	"github.com/kpumuk/lazykiq/internal/config"
 	"github.com/kpumuk/lazykiq/internal/sidekiq"
 	"github.com/kpumuk/lazykiq/internal/ui"
 )
@@ -49,6 +50,18 @@ func Execute(version, commit, date, builtBy string) error {
 	rootCmd.Version = buildVersion(version, commit, date, builtBy)
 	rootCmd.SetVersionTemplate(`lazykiq {{printf "version %s\n" .Version}}`)
 
+	rootCmd.Flags().String(
+		"config",
+		"",
+		"config file (default "+config.DefaultPath()+")",
+	)
+
+	rootCmd.Flags().String(
+		"context",
+		"",
+		"config context to use (default from default_context)",
+	)
+
 	rootCmd.Flags().String(
 		"cpuprofile",
 		"",
@@ -62,6 +75,12 @@ func Execute(version, commit, date, builtBy string) error {
 		"help for lazykiq",
 	)
 
+	rootCmd.Flags().String(
+		"theme",
+		"",
+		"theme name or path to a theme file",
+	)
+
 	rootCmd.Flags().String(
 		"redis",
 		"redis://localhost:6379/0",
@@ -79,6 +98,42 @@ func Execute(version, commit, date, builtBy string) error {
 			return fmt.Errorf("parse redis flag: %w", err)
 		}
 
+		configPath, err := cmd.Flags().GetString("config")
+		if err != nil {
+			return fmt.Errorf("parse config flag: %w", err)
+		}
+
+		cfg, err := config.Load(configPath)
+		if err != nil {
+			return fmt.Errorf("load config: %w", err)
+		}
+
+		themeName, err := cmd.Flags().GetString("theme")
+		if err != nil {
+			return fmt.Errorf("parse theme flag: %w", err)
+		}
+		if themeName != "" {
+			cfg.Theme = themeName
+		}
+
+		contextName, err := cmd.Flags().GetString("context")
+		if err != nil {
+			return fmt.Errorf("parse context flag: %w", err)
+		}
+		if contextName == "" {
+			contextName = cfg.DefaultContext
+		}
+		if contextName != "" {
+			ctx, ok := cfg.Context(contextName)
+			if !ok {
+				return fmt.Errorf("unknown context %q", contextName)
+			}
+			// An explicit --redis flag wins over the context's URL.
+			if ctx.Redis != "" && !cmd.Flags().Changed("redis") {
+				redisURL = ctx.Redis
+			}
+		}
+
 		client, err := sidekiq.NewClient(redisURL)
 		if err != nil {
 			return fmt.Errorf("create redis client: %w", err)
@@ -104,9 +159,16 @@ func Execute(version, commit, date, builtBy string) error {
 			}()
 		}
 
-		app := ui.New(client)
+		app, err := ui.New(client, cfg, contextName)
+		if err != nil {
+			return fmt.Errorf("configure lazykiq: %w", err)
+		}
 		p := tea.NewProgram(app)
-		if _, err := p.Run(); err != nil {
+		model, err := p.Run()
+		if final, ok := model.(ui.App); ok {
+			_ = final.Close()
+		}
+		if err != nil {
 			return fmt.Errorf("run lazykiq: %w", err)
 		}
 
@@ -0,0 +1,266 @@
+// Package config loads user settings for lazykiq from a TOML file.
+package config
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/BurntSushi/toml"
+)
+
+// Config holds all user-configurable settings.
+type Config struct {
+	// Clipboard selects how copy actions reach the clipboard:
+	// "auto" (default), "osc52", or "file".
+	Clipboard string `toml:"clipboard"`
+
+	// Theme selects a built-in theme ("default", "dark", "light",
+	// "high-contrast", "monochrome"), a theme file in the themes directory
+	// by name, or a path to a theme file.
+	Theme string `toml:"theme"`
+
+	// Views holds per-view settings keyed by lowercase view name (e.g. "retries").
+	Views map[string]View `toml:"views"`
+
+	// Mouse enables mouse support at startup. It can be toggled in the app;
+	// while enabled, terminal text selection usually needs a modifier key.
+	Mouse bool `toml:"mouse"`
+
+	// Split shows the job list and the selected job side by side in the
+	// Retries, Scheduled, and Dead views on wide terminals.
+	Split Split `toml:"split"`
+
+	// History records realtime dashboard samples per context so the
+	// realtime chart survives restarts.
+	History History `toml:"history"`
+
+	// Busy configures when running jobs are highlighted as long-running.
+	Busy Busy `toml:"busy"`
+
+	// Keys overrides key bindings per scope ("app", "table", "jobdetail"),
+	// mapping binding names (e.g. "line_down") to keys. An empty list
+	// disables the binding.
+	Keys map[string]map[string][]string `toml:"keys"`
+
+	// DefaultContext names the context used when --context is not given.
+	DefaultContext string `toml:"default_context"`
+
+	// Contexts holds named connection settings (e.g. "staging", "production").
+	Contexts map[string]Context `toml:"contexts"`
+}
+
+// Context describes a Sidekiq deployment lazykiq can connect to.
+type Context struct {
+	// Redis is the Redis URL for this deployment.
+	Redis string `toml:"redis"`
+
+	// PathMappings translate deployment paths found in backtraces
+	// to local checkout paths.
+	PathMappings []PathMapping `toml:"path_mappings"`
+}
+
+// Split configures the side-by-side job list and job details layout.
+type Split struct {
+	// Enabled turns the split layout on at startup (default true).
+	// It can be toggled in the app.
+	Enabled bool `toml:"enabled"`
+	// MinWidth is the terminal width from which the split layout is used.
+	MinWidth int `toml:"min_width"`
+}
+
+// DefaultSplitMinWidth is the default terminal width for the split layout.
+const DefaultSplitMinWidth = 180
+
+// History configures persisted realtime dashboard history.
+type History struct {
+	// Enabled records realtime samples to disk (default true).
+	Enabled bool `toml:"enabled"`
+	// Hours is how far back samples are kept and can be scrolled through.
+	Hours int `toml:"hours"`
+	// Dir overrides the directory history files are written to
+	// (default ~/.cache/lazykiq/history).
+	Dir string `toml:"dir"`
+}
+
+// DefaultHistoryHours is the default realtime history retention in hours.
+const DefaultHistoryHours = 24
+
+// Busy configures runtime thresholds for jobs in the Busy view.
+type Busy struct {
+	// Threshold is how long a job may run before it is highlighted
+	// (default 10m). Zero disables highlighting for classes without their
+	// own threshold.
+	Threshold time.Duration `toml:"threshold"`
+	// Thresholds overrides Threshold per job class (e.g. "ReportJob" = "1h").
+	Thresholds map[string]time.Duration `toml:"thresholds"`
+}
+
+// DefaultBusyThreshold is the default runtime after which jobs are
+// highlighted in the Busy view.
+const DefaultBusyThreshold = 10 * time.Minute
+
+// PathMapping maps a deployment path prefix to a local path prefix.
+type PathMapping struct {
+	// Remote is the path prefix used on the deployment (e.g. "/app/").
+	Remote string `toml:"remote"`
+	// Local is the matching local checkout path (e.g. "~/src/myapp/").
+	Local string `toml:"local"`
+}
+
+// View holds settings for a single view.
+type View struct {
+	// Columns lists visible table columns in display order.
+	// When empty, the view uses its built-in columns.
+	Columns []Column `toml:"columns"`
+}
+
+// Column configures a single table column.
+type Column struct {
+	// Name identifies the column (e.g. "class", "jid", "item.tenant_id").
+	Name string `toml:"name"`
+	// Title overrides the column header.
+	Title string `toml:"title"`
+	// Width overrides the minimum column width.
+	Width int `toml:"width"`
+}
+
+// Default returns the built-in configuration.
+func Default() Config {
+	return Config{
+		Views:    map[string]View{},
+		Contexts: map[string]Context{},
+		Split: Split{
+			Enabled:  true,
+			MinWidth: DefaultSplitMinWidth,
+		},
+		History: History{
+			Enabled: true,
+			Hours:   DefaultHistoryHours,
+		},
+		Busy: Busy{
+			Threshold: DefaultBusyThreshold,
+		},
+	}
+}
+
+// DefaultPath returns the default config file location
+// (e.g. ~/.config/lazykiq/config.toml).
+func DefaultPath() string {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(dir, "lazykiq", "config.toml")
+}
+
+// Load reads configuration from path.
+// When path is empty, the default location is used and a missing file is not an error.
+func Load(path string) (Config, error) {
+	cfg := Default()
+
+	optional := path == ""
+	if optional {
+		path = DefaultPath()
+		if path == "" {
+			return cfg, nil
+		}
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if optional && errors.Is(err, fs.ErrNotExist) {
+			return cfg, nil
+		}
+		return cfg, fmt.Errorf("read config %s: %w", path, err)
+	}
+
+	meta, err := toml.Decode(string(data), &cfg)
+	if err != nil {
+		return cfg, fmt.Errorf("parse config %s: %w", path, err)
+	}
+	if undecoded := meta.Undecoded(); len(undecoded) > 0 {
+		keys := make([]string, len(undecoded))
+		for i, key := range undecoded {
+			keys[i] = key.String()
+		}
+		return cfg, fmt.Errorf("parse config %s: unknown keys: %s", path, strings.Join(keys, ", "))
+	}
+	if cfg.Views == nil {
+		cfg.Views = map[string]View{}
+	}
+	if cfg.Contexts == nil {
+		cfg.Contexts = map[string]Context{}
+	}
+	switch cfg.Clipboard {
+	case "", "auto", "osc52", "file":
+	default:
+		return cfg, fmt.Errorf(`parse config %s: clipboard must be "auto", "osc52", or "file", got %q`, path, cfg.Clipboard)
+	}
+	if cfg.Split.MinWidth <= 0 {
+		return cfg, fmt.Errorf("parse config %s: split.min_width must be positive", path)
+	}
+	if cfg.History.Hours <= 0 {
+		return cfg, fmt.Errorf("parse config %s: history.hours must be positive", path)
+	}
+	cfg.History.Dir = expandHome(cfg.History.Dir)
+	if cfg.Busy.Threshold < 0 {
+		return cfg, fmt.Errorf("parse config %s: busy.threshold must not be negative", path)
+	}
+	for class, threshold := range cfg.Busy.Thresholds {
+		if threshold < 0 {
+			return cfg, fmt.Errorf("parse config %s: busy.thresholds.%s must not be negative", path, class)
+		}
+	}
+	if cfg.DefaultContext != "" {
+		if _, ok := cfg.Contexts[cfg.DefaultContext]; !ok {
+			return cfg, fmt.Errorf("parse config %s: default_context %q is not defined", path, cfg.DefaultContext)
+		}
+	}
+
+	return cfg, nil
+}
+
+// View returns settings for the named view.
+func (c Config) View(name string) View {
+	return c.Views[name]
+}
+
+// Context returns the named context.
+func (c Config) Context(name string) (Context, bool) {
+	ctx, ok := c.Contexts[name]
+	return ctx, ok
+}
+
+// MapPath rewrites a deployment path to a local path using the first
+// matching mapping. Paths without a matching mapping are returned unchanged.
+// A leading "~" in the local prefix expands to the user's home directory.
+func (c Context) MapPath(path string) string {
+	for _, mapping := range c.PathMappings {
+		if mapping.Remote == "" {
+			continue
+		}
+		rest, ok := strings.CutPrefix(path, mapping.Remote)
+		if !ok {
+			continue
+		}
+		return expandHome(mapping.Local) + rest
+	}
+	return path
+}
+
+func expandHome(path string) string {
+	rest, ok := strings.CutPrefix(path, "~")
+	if !ok || (rest != "" && rest[0] != '/') {
+		return path
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return path
+	}
+	return home + rest
+}
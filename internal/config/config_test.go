@@ -0,0 +1,246 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func writeConfig(t *testing.T, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "config.toml")
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatalf("write config: %v", err)
+	}
+	return path
+}
+
+func TestLoad_Columns(t *testing.T) {
+	path := writeConfig(t, `
+[[views.retries.columns]]
+name = "class"
+width = 40
+
+[[views.retries.columns]]
+name = "item.tenant_id"
+title = "Tenant"
+`)
+
+	cfg, err := Load(path)
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+
+	columns := cfg.View("retries").Columns
+	if len(columns) != 2 {
+		t.Fatalf("len(columns) = %d, want 2", len(columns))
+	}
+	if columns[0].Name != "class" || columns[0].Width != 40 {
+		t.Fatalf("columns[0] = %+v, want class/40", columns[0])
+	}
+	if columns[1].Name != "item.tenant_id" || columns[1].Title != "Tenant" {
+		t.Fatalf("columns[1] = %+v, want item.tenant_id/Tenant", columns[1])
+	}
+	if got := cfg.View("dead").Columns; got != nil {
+		t.Fatalf("dead columns = %+v, want nil", got)
+	}
+}
+
+func TestLoad_UnknownKeys(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    string
+	}{
+		{name: "top-level", content: "theme = \"dark\"\nmosue = true\n", want: "unknown keys: mosue"},
+		{name: "view-field", content: "[views.retries]\ncolumn = []\n", want: "unknown keys: views.retries.column"},
+		{name: "column-field", content: "[[views.retries.columns]]\nname = \"jid\"\nwidht = 10\n", want: "views.retries.columns.widht"},
+		{name: "split", content: "[split]\nmin_widht = 100\n", want: "split.min_widht"},
+		{name: "history", content: "[history]\nhour = 2\n", want: "history.hour"},
+		{name: "busy", content: "[busy]\nthreshhold = \"1m\"\n", want: "busy.threshhold"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Load(writeConfig(t, tt.content))
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Fatalf("Load() error = %v, want containing %q", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestLoad_MissingExplicitFile(t *testing.T) {
+	_, err := Load(filepath.Join(t.TempDir(), "missing.toml"))
+	if err == nil {
+		t.Fatal("Load() error = nil, want error")
+	}
+}
+
+func TestLoad_MissingDefaultFile(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	t.Setenv("HOME", t.TempDir())
+
+	cfg, err := Load("")
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if cfg.Views == nil {
+		t.Fatal("Views = nil, want empty map")
+	}
+}
+
+func TestLoad_InvalidTOML(t *testing.T) {
+	path := writeConfig(t, "[views\n")
+
+	if _, err := Load(path); err == nil {
+		t.Fatal("Load() error = nil, want error")
+	}
+}
+
+func TestLoad_Contexts(t *testing.T) {
+	path := writeConfig(t, `
+default_context = "production"
+
+[contexts.production]
+redis = "redis://prod:6379/0"
+
+[[contexts.production.path_mappings]]
+remote = "/app/"
+local = "/home/me/src/app/"
+`)
+
+	cfg, err := Load(path)
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+
+	ctx, ok := cfg.Context(cfg.DefaultContext)
+	if !ok {
+		t.Fatalf("Context(%q) not found", cfg.DefaultContext)
+	}
+	if ctx.Redis != "redis://prod:6379/0" {
+		t.Fatalf("Redis = %q, want redis://prod:6379/0", ctx.Redis)
+	}
+	if got := ctx.MapPath("/app/app/jobs/report_job.rb"); got != "/home/me/src/app/app/jobs/report_job.rb" {
+		t.Fatalf("MapPath() = %q", got)
+	}
+}
+
+func TestLoad_UnknownDefaultContext(t *testing.T) {
+	path := writeConfig(t, `default_context = "missing"`)
+
+	if _, err := Load(path); err == nil {
+		t.Fatal("Load() error = nil, want error")
+	}
+}
+
+func TestLoad_Clipboard(t *testing.T) {
+	cfg, err := Load(writeConfig(t, `clipboard = "file"`))
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if cfg.Clipboard != "file" {
+		t.Fatalf("Clipboard = %q, want file", cfg.Clipboard)
+	}
+
+	if _, err := Load(writeConfig(t, `clipboard = "osc-52"`)); err == nil || !strings.Contains(err.Error(), `got "osc-52"`) {
+		t.Fatalf("Load() error = %v, want invalid clipboard error", err)
+	}
+}
+
+func TestLoad_Split(t *testing.T) {
+	cfg, err := Load(writeConfig(t, `mouse = true`))
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if !cfg.Split.Enabled || cfg.Split.MinWidth != DefaultSplitMinWidth {
+		t.Fatalf("Split = %+v, want enabled with default min width", cfg.Split)
+	}
+
+	cfg, err = Load(writeConfig(t, "[split]\nenabled = false\nmin_width = 240\n"))
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if cfg.Split.Enabled || cfg.Split.MinWidth != 240 {
+		t.Fatalf("Split = %+v, want disabled with min width 240", cfg.Split)
+	}
+
+	if _, err := Load(writeConfig(t, "[split]\nmin_width = 0\n")); err == nil {
+		t.Fatal("Load() error = nil, want error for non-positive min_width")
+	}
+}
+
+func TestLoad_History(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+
+	cfg, err := Load(writeConfig(t, `mouse = true`))
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if !cfg.History.Enabled || cfg.History.Hours != DefaultHistoryHours || cfg.History.Dir != "" {
+		t.Fatalf("History = %+v, want enabled with default hours", cfg.History)
+	}
+
+	cfg, err = Load(writeConfig(t, "[history]\nenabled = false\nhours = 6\ndir = \"~/lazykiq\"\n"))
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if cfg.History.Enabled || cfg.History.Hours != 6 || cfg.History.Dir != home+"/lazykiq" {
+		t.Fatalf("History = %+v, want disabled, 6 hours, expanded dir", cfg.History)
+	}
+
+	if _, err := Load(writeConfig(t, "[history]\nhours = 0\n")); err == nil {
+		t.Fatal("Load() error = nil, want error for non-positive hours")
+	}
+}
+
+func TestContext_MapPath(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+
+	ctx := Context{PathMappings: []PathMapping{
+		{Remote: "/app/", Local: "~/src/app/"},
+		{Remote: "/srv/", Local: "/opt/srv/"},
+	}}
+
+	tests := []struct {
+		path string
+		want string
+	}{
+		{path: "/app/lib/foo.rb", want: home + "/src/app/lib/foo.rb"},
+		{path: "/srv/lib/bar.rb", want: "/opt/srv/lib/bar.rb"},
+		{path: "/usr/lib/ruby/net/http.rb", want: "/usr/lib/ruby/net/http.rb"},
+	}
+
+	for _, tt := range tests {
+		if got := ctx.MapPath(tt.path); got != tt.want {
+			t.Errorf("MapPath(%q) = %q, want %q", tt.path, got, tt.want)
+		}
+	}
+}
+
+func TestLoad_Busy(t *testing.T) {
+	cfg, err := Load(writeConfig(t, `mouse = true`))
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if cfg.Busy.Threshold != DefaultBusyThreshold || len(cfg.Busy.Thresholds) != 0 {
+		t.Fatalf("Busy = %+v, want default threshold", cfg.Busy)
+	}
+
+	cfg, err = Load(writeConfig(t, "[busy]\nthreshold = \"2m\"\n\n[busy.thresholds]\nReportJob = \"1h30m\"\n"))
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if cfg.Busy.Threshold != 2*time.Minute || cfg.Busy.Thresholds["ReportJob"] != 90*time.Minute {
+		t.Fatalf("Busy = %+v, want 2m with a 1h30m ReportJob threshold", cfg.Busy)
+	}
+
+	if _, err := Load(writeConfig(t, "[busy]\nthreshold = \"-1m\"\n")); err == nil {
+		t.Fatal("Load() error = nil, want error for negative threshold")
+	}
+}
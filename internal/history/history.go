@@ -0,0 +1,216 @@
+// Package history persists realtime Sidekiq stats samples to an append-only
+// JSON Lines file per context, so dashboard charts survive restarts.
+package history
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Sample is a point-in-time snapshot of Sidekiq stats.
+type Sample struct {
+	At        time.Time `json:"at"`
+	Processed int64     `json:"processed"`
+	Failed    int64     `json:"failed"`
+	Enqueued  int64     `json:"enqueued"`
+	Busy      int64     `json:"busy"`
+	Retries   int64     `json:"retries"`
+	Dead      int64     `json:"dead"`
+	// QueueLatencies maps queue names to their latency in seconds.
+	QueueLatencies map[string]float64 `json:"queue_latencies,omitempty"`
+}
+
+// Store appends samples to a file and loads those within the retention window.
+type Store struct {
+	path      string
+	retention time.Duration
+	mu        sync.Mutex
+
+	// compactedAt is when expired samples were last dropped from the file.
+	// Appending compacts it again once a retention period has passed, so the
+	// file holds at most two periods of samples.
+	compactedAt time.Time
+}
+
+// NewStore creates a store backed by the file at path that keeps samples
+// for the given retention.
+func NewStore(path string, retention time.Duration) *Store {
+	return &Store{path: path, retention: retention}
+}
+
+// DefaultDir returns the default directory for history files
+// (e.g. ~/.cache/lazykiq/history).
+func DefaultDir() string {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(dir, "lazykiq", "history")
+}
+
+// FileName returns a file name for the named context, replacing characters
+// that are unsafe in file names. A short hash of the name keeps contexts
+// that differ only in those characters, or in case, apart.
+func FileName(name string) string {
+	safe := strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9', r == '-', r == '_', r == '.':
+			return r
+		default:
+			return '_'
+		}
+	}, name)
+	if safe == "" || strings.Trim(safe, ".") == "" {
+		safe = "default"
+	}
+	sum := sha256.Sum256([]byte(name))
+	return safe + "-" + hex.EncodeToString(sum[:4]) + ".jsonl"
+}
+
+// Path returns the file the store writes to.
+func (s *Store) Path() string {
+	return s.path
+}
+
+// Retention returns how long samples are kept.
+func (s *Store) Retention() time.Duration {
+	return s.retention
+}
+
+// Append writes a sample to the end of the file, creating it when needed,
+// and drops expired samples once a retention period passed since the last
+// compaction.
+func (s *Store) Append(sample Sample) error {
+	line, err := json.Marshal(sample)
+	if err != nil {
+		return fmt.Errorf("encode history sample: %w", err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := os.MkdirAll(filepath.Dir(s.path), 0o700); err != nil {
+		return fmt.Errorf("create history dir: %w", err)
+	}
+	file, err := os.OpenFile(s.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return fmt.Errorf("open history %s: %w", s.path, err)
+	}
+	if _, err := file.Write(append(line, '\n')); err != nil {
+		_ = file.Close()
+		return fmt.Errorf("write history %s: %w", s.path, err)
+	}
+	if err := file.Close(); err != nil {
+		return fmt.Errorf("close history %s: %w", s.path, err)
+	}
+
+	if s.compactedAt.IsZero() {
+		s.compactedAt = sample.At
+	}
+	if sample.At.Sub(s.compactedAt) < s.retention {
+		return nil
+	}
+	samples, dropped, err := s.read(sample.At)
+	if err != nil {
+		return err
+	}
+	s.compactedAt = sample.At
+	if dropped == 0 {
+		return nil
+	}
+	return s.rewrite(samples)
+}
+
+// Load reads samples recorded since now minus the retention, in file order.
+// Malformed lines are skipped. When most of the file has expired, it is
+// rewritten with the remaining samples to keep it from growing unbounded.
+func (s *Store) Load(now time.Time) ([]Sample, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	samples, dropped, err := s.read(now)
+	if err != nil {
+		return nil, err
+	}
+	if dropped > 0 && dropped >= len(samples) {
+		if err := s.rewrite(samples); err != nil {
+			return samples, err
+		}
+		s.compactedAt = now
+	}
+	return samples, nil
+}
+
+// read returns the samples recorded since now minus the retention and the
+// number of expired or malformed lines.
+func (s *Store) read(now time.Time) ([]Sample, int, error) {
+	file, err := os.Open(s.path)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, 0, nil
+		}
+		return nil, 0, fmt.Errorf("open history %s: %w", s.path, err)
+	}
+	defer func() {
+		_ = file.Close()
+	}()
+
+	cutoff := now.Add(-s.retention)
+	var samples []Sample
+	dropped := 0
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		var sample Sample
+		if err := json.Unmarshal(scanner.Bytes(), &sample); err != nil || sample.At.Before(cutoff) {
+			dropped++
+			continue
+		}
+		samples = append(samples, sample)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, 0, fmt.Errorf("read history %s: %w", s.path, err)
+	}
+	return samples, dropped, nil
+}
+
+// rewrite atomically replaces the file with the given samples.
+func (s *Store) rewrite(samples []Sample) error {
+	tmp, err := os.CreateTemp(filepath.Dir(s.path), filepath.Base(s.path)+".*.tmp")
+	if err != nil {
+		return fmt.Errorf("compact history %s: %w", s.path, err)
+	}
+	writer := bufio.NewWriter(tmp)
+	encoder := json.NewEncoder(writer)
+	for _, sample := range samples {
+		if err := encoder.Encode(sample); err != nil {
+			_ = tmp.Close()
+			_ = os.Remove(tmp.Name())
+			return fmt.Errorf("compact history %s: %w", s.path, err)
+		}
+	}
+	if err := writer.Flush(); err != nil {
+		_ = tmp.Close()
+		_ = os.Remove(tmp.Name())
+		return fmt.Errorf("compact history %s: %w", s.path, err)
+	}
+	if err := tmp.Close(); err != nil {
+		_ = os.Remove(tmp.Name())
+		return fmt.Errorf("compact history %s: %w", s.path, err)
+	}
+	if err := os.Rename(tmp.Name(), s.path); err != nil {
+		_ = os.Remove(tmp.Name())
+		return fmt.Errorf("compact history %s: %w", s.path, err)
+	}
+	return nil
+}
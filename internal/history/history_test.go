@@ -0,0 +1,130 @@
+package history
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestStore_AppendAndLoad(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "nested", "prod.jsonl")
+	store := NewStore(path, time.Hour)
+	now := time.Date(2026, 1, 2, 12, 0, 0, 0, time.UTC)
+
+	samples := []Sample{
+		{At: now.Add(-2 * time.Minute), Processed: 10, Failed: 1, QueueLatencies: map[string]float64{"default": 1.5}},
+		{At: now.Add(-time.Minute), Processed: 15, Failed: 2, Enqueued: 3, Busy: 4, Retries: 5, Dead: 6},
+	}
+	for _, sample := range samples {
+		if err := store.Append(sample); err != nil {
+			t.Fatalf("Append() error = %v", err)
+		}
+	}
+
+	got, err := store.Load(now)
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if len(got) != 2 {
+		t.Fatalf("Load() returned %d samples, want 2", len(got))
+	}
+	if got[0].QueueLatencies["default"] != 1.5 {
+		t.Fatalf("queue latency = %v, want 1.5", got[0].QueueLatencies["default"])
+	}
+	if got[1].Processed != 15 || got[1].Dead != 6 || !got[1].At.Equal(samples[1].At) {
+		t.Fatalf("sample = %+v, want %+v", got[1], samples[1])
+	}
+}
+
+func TestStore_LoadMissingFile(t *testing.T) {
+	store := NewStore(filepath.Join(t.TempDir(), "missing.jsonl"), time.Hour)
+	got, err := store.Load(time.Now())
+	if err != nil || got != nil {
+		t.Fatalf("Load() = %v, %v, want nil, nil", got, err)
+	}
+}
+
+func TestStore_LoadDropsExpiredAndMalformed(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "default.jsonl")
+	store := NewStore(path, time.Hour)
+	now := time.Date(2026, 1, 2, 12, 0, 0, 0, time.UTC)
+
+	for _, at := range []time.Time{now.Add(-3 * time.Hour), now.Add(-2 * time.Hour), now.Add(-time.Minute)} {
+		if err := store.Append(Sample{At: at}); err != nil {
+			t.Fatalf("Append() error = %v", err)
+		}
+	}
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_WRONLY, 0o600)
+	if err != nil {
+		t.Fatalf("open: %v", err)
+	}
+	_, _ = file.WriteString("not json\n")
+	_ = file.Close()
+
+	got, err := store.Load(now)
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if len(got) != 1 || !got[0].At.Equal(now.Add(-time.Minute)) {
+		t.Fatalf("Load() = %+v, want only the recent sample", got)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("read: %v", err)
+	}
+	if lines := strings.Count(string(data), "\n"); lines != 1 {
+		t.Fatalf("file has %d lines after compaction, want 1", lines)
+	}
+}
+
+func TestStore_AppendCompactsAfterRetention(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "default.jsonl")
+	store := NewStore(path, time.Hour)
+	start := time.Date(2026, 1, 2, 12, 0, 0, 0, time.UTC)
+
+	// Three hours of samples every 10 minutes, never loading the file
+	for at := start; !at.After(start.Add(3 * time.Hour)); at = at.Add(10 * time.Minute) {
+		if err := store.Append(Sample{At: at}); err != nil {
+			t.Fatalf("Append() error = %v", err)
+		}
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("read: %v", err)
+	}
+	// Compacted at the last sample, keeping the hour before it
+	if lines := strings.Count(string(data), "\n"); lines != 7 {
+		t.Fatalf("file has %d lines, want 7", lines)
+	}
+}
+
+func TestFileName(t *testing.T) {
+	tests := map[string]string{
+		"production":          "production-ab8e18ef.jsonl",
+		"localhost:6379/0":    "localhost_6379_0-2b090b58.jsonl",
+		"":                    "default-e3b0c442.jsonl",
+		"..":                  "default-5ec1f7e7.jsonl",
+		"eu-west.staging_app": "eu-west.staging_app-ab19e8a5.jsonl",
+	}
+	for name, want := range tests {
+		if got := FileName(name); got != want {
+			t.Errorf("FileName(%q) = %q, want %q", name, got, want)
+		}
+	}
+}
+
+func TestFileName_Distinct(t *testing.T) {
+	names := []string{"prod/eu", "prod_eu", "prod:eu", "Prod_eu"}
+	seen := map[string]string{}
+	for _, name := range names {
+		file := strings.ToLower(FileName(name))
+		if other, ok := seen[file]; ok {
+			t.Fatalf("FileName(%q) = FileName(%q) = %q", name, other, file)
+		}
+		seen[file] = name
+	}
+}
@@ -0,0 +1,59 @@
+package sidekiq
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// backtraceFramePattern matches Ruby backtrace lines such as
+// "/app/app/jobs/report_job.rb:42:in `perform'" or "lib/foo.rb:7:in 'Foo#bar'".
+var backtraceFramePattern = regexp.MustCompile("^(.+?):(\\d+)(?::in [`'](.*)')?$")
+
+// libraryPathMarkers identify frames inside installed gems or the Ruby standard library.
+var libraryPathMarkers = []string{
+	"/gems/",
+	"/rubygems/",
+	"/lib/ruby/",
+	"/vendor/bundle/",
+}
+
+// BacktraceFrame is a single parsed line of a Ruby backtrace.
+type BacktraceFrame struct {
+	File  string
+	Line  int
+	Label string
+}
+
+// ParseBacktraceFrame parses a Ruby backtrace line into file, line, and label.
+func ParseBacktraceFrame(line string) (BacktraceFrame, bool) {
+	matches := backtraceFramePattern.FindStringSubmatch(strings.TrimSpace(line))
+	if matches == nil {
+		return BacktraceFrame{}, false
+	}
+	lineNo, err := strconv.Atoi(matches[2])
+	if err != nil {
+		return BacktraceFrame{}, false
+	}
+	return BacktraceFrame{File: matches[1], Line: lineNo, Label: matches[3]}, true
+}
+
+// IsLibrary reports whether the frame points into a gem or the Ruby standard library.
+func (f BacktraceFrame) IsLibrary() bool {
+	if strings.HasPrefix(f.File, "<internal:") {
+		return true
+	}
+	for _, marker := range libraryPathMarkers {
+		if strings.Contains(f.File, marker) {
+			return true
+		}
+	}
+	return false
+}
+
+// IsLibraryFrame reports whether a raw backtrace line points into a gem or the
+// Ruby standard library. Lines that cannot be parsed are not library frames.
+func IsLibraryFrame(line string) bool {
+	frame, ok := ParseBacktraceFrame(line)
+	return ok && frame.IsLibrary()
+}
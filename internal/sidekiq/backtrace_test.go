@@ -0,0 +1,70 @@
+package sidekiq
+
+import "testing"
+
+func TestParseBacktraceFrame(t *testing.T) {
+	tests := []struct {
+		name string
+		line string
+		want BacktraceFrame
+		ok   bool
+	}{
+		{
+			name: "backtick",
+			line: "/app/app/jobs/report_job.rb:42:in `perform'",
+			want: BacktraceFrame{File: "/app/app/jobs/report_job.rb", Line: 42, Label: "perform"},
+			ok:   true,
+		},
+		{
+			name: "ruby-3.4-quote",
+			line: "/app/lib/foo.rb:7:in 'Foo#bar'",
+			want: BacktraceFrame{File: "/app/lib/foo.rb", Line: 7, Label: "Foo#bar"},
+			ok:   true,
+		},
+		{
+			name: "no-label",
+			line: "lib/foo.rb:3",
+			want: BacktraceFrame{File: "lib/foo.rb", Line: 3},
+			ok:   true,
+		},
+		{
+			name: "internal",
+			line: "<internal:kernel>:187:in `loop'",
+			want: BacktraceFrame{File: "<internal:kernel>", Line: 187, Label: "loop"},
+			ok:   true,
+		},
+		{name: "garbage", line: "something went wrong", ok: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := ParseBacktraceFrame(tt.line)
+			if ok != tt.ok {
+				t.Fatalf("ParseBacktraceFrame(%q) ok = %v, want %v", tt.line, ok, tt.ok)
+			}
+			if got != tt.want {
+				t.Fatalf("ParseBacktraceFrame(%q) = %+v, want %+v", tt.line, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsLibraryFrame(t *testing.T) {
+	tests := []struct {
+		line string
+		want bool
+	}{
+		{line: "/app/app/jobs/report_job.rb:42:in `perform'", want: false},
+		{line: "/usr/local/bundle/gems/sidekiq-7.2.0/lib/sidekiq/processor.rb:202:in `execute_job'", want: true},
+		{line: "/app/vendor/bundle/ruby/3.3.0/bundler/gems/foo/lib/foo.rb:1", want: true},
+		{line: "/usr/local/lib/ruby/3.3.0/net/http.rb:1603:in `request'", want: true},
+		{line: "<internal:kernel>:187:in `loop'", want: true},
+		{line: "not a frame", want: false},
+	}
+
+	for _, tt := range tests {
+		if got := IsLibraryFrame(tt.line); got != tt.want {
+			t.Errorf("IsLibraryFrame(%q) = %v, want %v", tt.line, got, tt.want)
+		}
+	}
+}
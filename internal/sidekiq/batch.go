@@ -0,0 +1,250 @@
+package sidekiq
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// ErrBatchNotFound is returned when a batch hash does not exist, usually
+// because the batch expired after completing.
+var ErrBatchNotFound = errors.New("batch not found")
+
+// batchSetKey is the sorted set of batch IDs Sidekiq Pro keeps for its
+// web UI.
+const batchSetKey = "batches"
+
+// batchJobsLimit caps the child jobs read from a batch, as huge batches
+// may have millions of pending jobs.
+const batchJobsLimit = 1000
+
+// Child job statuses of a batch. Succeeded jobs are not tracked by JID.
+const (
+	BatchJobPending = "pending"
+	BatchJobFailed  = "failed"
+	BatchJobDead    = "dead"
+)
+
+// BatchCallback is a callback registered for a batch event.
+type BatchCallback struct {
+	Event   string
+	Class   string
+	Options any
+}
+
+// Batch is a Sidekiq Pro batch read from its b-<bid> hash.
+type Batch struct {
+	BID         string
+	Description string
+	CreatedAt   float64
+	Total       int64
+	// Pending counts jobs that did not succeed yet, including failed ones.
+	Pending  int64
+	Failures int64
+	Parent   string
+	Kids     int64
+	// CallbackQueue is the queue callbacks are enqueued to.
+	CallbackQueue string
+	Callbacks     []BatchCallback
+	// Fields holds the raw hash, including fields not parsed above.
+	Fields map[string]string
+}
+
+// Succeeded returns the number of jobs that succeeded.
+func (b Batch) Succeeded() int64 {
+	return max(b.Total-b.Pending, 0)
+}
+
+// Progress returns the share of succeeded jobs, from 0 to 1.
+func (b Batch) Progress() float64 {
+	if b.Total == 0 {
+		return 0
+	}
+	return float64(b.Succeeded()) / float64(b.Total)
+}
+
+// BatchJob is a child job of a batch that did not succeed yet.
+type BatchJob struct {
+	JID          string
+	Status       string
+	ErrorClass   string
+	ErrorMessage string
+}
+
+// parseBatch builds a batch from its hash fields.
+func parseBatch(bid string, fields map[string]string) Batch {
+	batch := Batch{
+		BID:           bid,
+		Description:   fields["description"],
+		Parent:        fields["parent"],
+		CallbackQueue: fields["cbq"],
+		Fields:        fields,
+	}
+	batch.CreatedAt, _ = strconv.ParseFloat(fields["created_at"], 64)
+	batch.Total, _ = strconv.ParseInt(fields["total"], 10, 64)
+	batch.Pending, _ = strconv.ParseInt(fields["pending"], 10, 64)
+	batch.Failures, _ = strconv.ParseInt(fields["failures"], 10, 64)
+	batch.Kids, _ = strconv.ParseInt(fields["kids"], 10, 64)
+	batch.Callbacks = parseBatchCallbacks(fields["callbacks"])
+	return batch
+}
+
+// parseBatchCallbacks reads callbacks stored as JSON, keyed by event with a
+// list of {"Class": options} objects or class names.
+func parseBatchCallbacks(raw string) []BatchCallback {
+	if raw == "" {
+		return nil
+	}
+	var events map[string][]any
+	if err := json.Unmarshal([]byte(raw), &events); err != nil {
+		return nil
+	}
+
+	var callbacks []BatchCallback
+	for event, entries := range events {
+		for _, entry := range entries {
+			switch entry := entry.(type) {
+			case string:
+				callbacks = append(callbacks, BatchCallback{Event: event, Class: entry})
+			case map[string]any:
+				for class, options := range entry {
+					callbacks = append(callbacks, BatchCallback{Event: event, Class: class, Options: options})
+				}
+			}
+		}
+	}
+	slices.SortFunc(callbacks, func(a, b BatchCallback) int {
+		if c := cmp.Compare(a.Event, b.Event); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.Class, b.Class)
+	})
+	return callbacks
+}
+
+// GetBatches fetches batches with pagination, newest first.
+func (c *Client) GetBatches(ctx context.Context, start, count int) ([]Batch, int64, error) {
+	size, err := c.redis.ZCard(ctx, batchSetKey).Result()
+	if err != nil && err != redis.Nil {
+		return nil, 0, err
+	}
+	if size == 0 {
+		return nil, 0, nil
+	}
+
+	bids, err := c.redis.ZRevRange(ctx, batchSetKey, int64(start), int64(start+count-1)).Result()
+	if err != nil {
+		return nil, size, err
+	}
+
+	pipe := c.redis.Pipeline()
+	cmds := make([]*redis.MapStringStringCmd, len(bids))
+	for i, bid := range bids {
+		cmds[i] = pipe.HGetAll(ctx, "b-"+bid)
+	}
+	if _, err := pipe.Exec(ctx); err != nil && err != redis.Nil {
+		return nil, size, fmt.Errorf("fetch batches: %w", err)
+	}
+
+	batches := make([]Batch, 0, len(bids))
+	for i, cmd := range cmds {
+		fields, err := cmd.Result()
+		if err != nil && err != redis.Nil {
+			return nil, size, fmt.Errorf("fetch batches: %w", err)
+		}
+		// Expired batches linger in the set until Sidekiq Pro prunes it
+		if len(fields) == 0 {
+			continue
+		}
+		batches = append(batches, parseBatch(bids[i], fields))
+	}
+	return batches, size, nil
+}
+
+// GetBatch fetches a batch with its pending, failed, and dead child jobs.
+// It returns ErrBatchNotFound when the batch does not exist.
+func (c *Client) GetBatch(ctx context.Context, bid string) (Batch, []BatchJob, error) {
+	key := "b-" + bid
+	pipe := c.redis.Pipeline()
+	fieldsCmd := pipe.HGetAll(ctx, key)
+	pendingCmd := pipe.SRandMemberN(ctx, key+"-jids", batchJobsLimit)
+	failuresCmd := pipe.HGetAll(ctx, key+"-failinfo")
+	deadCmd := pipe.SMembers(ctx, key+"-died")
+	if _, err := pipe.Exec(ctx); err != nil && err != redis.Nil {
+		return Batch{}, nil, fmt.Errorf("fetch batch %s: %w", bid, err)
+	}
+
+	fields, err := fieldsCmd.Result()
+	if err != nil && err != redis.Nil {
+		return Batch{}, nil, fmt.Errorf("fetch batch %s: %w", bid, err)
+	}
+	if len(fields) == 0 {
+		return Batch{}, nil, ErrBatchNotFound
+	}
+	pending, _ := pendingCmd.Result()
+	failures, _ := failuresCmd.Result()
+	dead, _ := deadCmd.Result()
+
+	return parseBatch(bid, fields), batchJobs(pending, failures, dead), nil
+}
+
+// batchJobs merges pending JIDs with failure info and dead JIDs. Failed
+// jobs stay pending until they succeed, dead jobs never do. Jobs are
+// listed dead first, then failed, then pending, by JID.
+func batchJobs(pending []string, failures map[string]string, dead []string) []BatchJob {
+	index := make(map[string]int)
+	var jobs []BatchJob
+	add := func(jid, status string) *BatchJob {
+		i, ok := index[jid]
+		if !ok {
+			i = len(jobs)
+			index[jid] = i
+			jobs = append(jobs, BatchJob{JID: jid, Status: status})
+		}
+		return &jobs[i]
+	}
+
+	for _, jid := range pending {
+		add(jid, BatchJobPending)
+	}
+	for jid, info := range failures {
+		job := add(jid, BatchJobFailed)
+		job.Status = BatchJobFailed
+		job.ErrorClass, job.ErrorMessage = parseBatchFailure(info)
+	}
+	for _, jid := range dead {
+		add(jid, BatchJobDead).Status = BatchJobDead
+	}
+
+	rank := map[string]int{BatchJobDead: 0, BatchJobFailed: 1, BatchJobPending: 2}
+	slices.SortFunc(jobs, func(a, b BatchJob) int {
+		if c := cmp.Compare(rank[a.Status], rank[b.Status]); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.JID, b.JID)
+	})
+	return jobs
+}
+
+// parseBatchFailure reads the error class and message of a failed job,
+// stored as a JSON array of [class, message, backtrace].
+func parseBatchFailure(info string) (string, string) {
+	var values []any
+	if err := json.Unmarshal([]byte(info), &values); err != nil {
+		return "", info
+	}
+	var class, message string
+	if len(values) > 0 {
+		class, _ = values[0].(string)
+	}
+	if len(values) > 1 {
+		message, _ = values[1].(string)
+	}
+	return class, message
+}
@@ -0,0 +1,58 @@
+package sidekiq
+
+import "testing"
+
+func TestParseBatch(t *testing.T) {
+	batch := parseBatch("abc123", map[string]string{
+		"description": "Import users",
+		"created_at":  "1700000000.5",
+		"total":       "10",
+		"pending":     "4",
+		"failures":    "1",
+		"kids":        "2",
+		"parent":      "parent1",
+		"cbq":         "critical",
+		"callbacks":   `{"success":[{"ImportCallback":{"user_id":1}}],"complete":["Notifier"]}`,
+	})
+
+	if batch.BID != "abc123" || batch.Description != "Import users" || batch.CreatedAt != 1700000000.5 {
+		t.Fatalf("batch = %+v", batch)
+	}
+	if batch.Total != 10 || batch.Pending != 4 || batch.Failures != 1 || batch.Kids != 2 || batch.Parent != "parent1" {
+		t.Fatalf("batch counts = %+v", batch)
+	}
+	if batch.Succeeded() != 6 || batch.Progress() != 0.6 {
+		t.Fatalf("Succeeded() = %d, Progress() = %v, want 6 and 0.6", batch.Succeeded(), batch.Progress())
+	}
+	if len(batch.Callbacks) != 2 || batch.Callbacks[0].Event != "complete" || batch.Callbacks[0].Class != "Notifier" ||
+		batch.Callbacks[1].Class != "ImportCallback" || batch.Callbacks[1].Options == nil {
+		t.Fatalf("callbacks = %+v", batch.Callbacks)
+	}
+
+	if empty := parseBatch("x", map[string]string{"callbacks": "not json"}); empty.Progress() != 0 || empty.Callbacks != nil {
+		t.Fatalf("empty batch = %+v", empty)
+	}
+}
+
+func TestBatchJobs(t *testing.T) {
+	jobs := batchJobs(
+		[]string{"p2", "f1", "p1"},
+		map[string]string{"f1": `["RuntimeError","boom","backtrace"]`, "d1": "garbage"},
+		[]string{"d1"},
+	)
+
+	want := []BatchJob{
+		{JID: "d1", Status: BatchJobDead, ErrorMessage: "garbage"},
+		{JID: "f1", Status: BatchJobFailed, ErrorClass: "RuntimeError", ErrorMessage: "boom"},
+		{JID: "p1", Status: BatchJobPending},
+		{JID: "p2", Status: BatchJobPending},
+	}
+	if len(jobs) != len(want) {
+		t.Fatalf("jobs = %+v, want %+v", jobs, want)
+	}
+	for i := range want {
+		if jobs[i] != want[i] {
+			t.Errorf("jobs[%d] = %+v, want %+v", i, jobs[i], want[i])
+		}
+	}
+}
@@ -0,0 +1,203 @@
+package sidekiq
+
+import (
+	"cmp"
+	"slices"
+	"strconv"
+)
+
+// Capsule modes, matching how a Sidekiq capsule picks the next queue.
+const (
+	// CapsuleStrict checks queues in order, so earlier queues always win.
+	CapsuleStrict = "strict"
+	// CapsuleWeighted picks queues randomly, proportionally to their weights.
+	CapsuleWeighted = "weighted"
+	// CapsuleRandom picks queues randomly with equal weights.
+	CapsuleRandom = "random"
+)
+
+// Capsule is a pool of threads processing its own queues. Sidekiq 7 runs
+// one or more capsules per process; older versions run a single one.
+type Capsule struct {
+	Name string
+	// Concurrency is the number of capsule threads, or zero when unknown:
+	// Sidekiq 7 heartbeats only report the total of the process.
+	Concurrency int
+	Mode        string
+	// Queues lists the capsule queues in priority order.
+	Queues  []string
+	Weights map[string]int
+}
+
+// Share returns the fraction of the capsule threads expected to work on a
+// queue while all its queues have jobs. A strict capsule drains queues in
+// order, so every queue may get all threads once earlier queues are empty.
+func (c Capsule) Share(queue string) float64 {
+	if !slices.Contains(c.Queues, queue) {
+		return 0
+	}
+	switch c.Mode {
+	case CapsuleStrict:
+		return 1
+	case CapsuleWeighted:
+		total := 0
+		for _, q := range c.Queues {
+			total += max(c.Weights[q], 1)
+		}
+		return float64(max(c.Weights[queue], 1)) / float64(total)
+	default:
+		return 1 / float64(len(c.Queues))
+	}
+}
+
+// capsuleMode derives the mode from queue weights like Sidekiq does:
+// no weights means strict ordering, equal weights of 1 mean random.
+func capsuleMode(queues []string, weights map[string]int) string {
+	strict, random := true, true
+	for _, q := range queues {
+		w := weights[q]
+		strict = strict && w == 0
+		random = random && w == 1
+	}
+	switch {
+	case strict:
+		return CapsuleStrict
+	case random:
+		return CapsuleRandom
+	default:
+		return CapsuleWeighted
+	}
+}
+
+// parseCapsules reads capsules from the process info. Capsules are taken
+// from the "capsules" object when present, from the per-capsule "weights"
+// otherwise, and from the queue list for Sidekiq versions before 7, which
+// repeat a queue once per weight.
+func parseCapsules(info map[string]any, process *Process) {
+	if capsules, ok := info["capsules"].(map[string]any); ok {
+		for name, value := range capsules {
+			data, ok := value.(map[string]any)
+			if !ok {
+				continue
+			}
+			capsule := Capsule{Name: name, Weights: intMap(data["weights"])}
+			if concurrency, ok := data["concurrency"].(float64); ok {
+				capsule.Concurrency = int(concurrency)
+			}
+			capsule.Queues = orderedQueues(capsule.Weights, process.Queues)
+			if queues, ok := data["queues"].([]any); ok {
+				capsule.Queues = uniqueStrings(queues)
+			}
+			capsule.Mode, _ = data["mode"].(string)
+			if capsule.Mode == "" {
+				capsule.Mode = capsuleMode(capsule.Queues, capsule.Weights)
+			}
+			process.Capsules = append(process.Capsules, capsule)
+		}
+		slices.SortFunc(process.Capsules, func(a, b Capsule) int {
+			return cmp.Compare(a.Name, b.Name)
+		})
+		return
+	}
+
+	if len(process.Weights) > 0 {
+		// A single capsule has all threads, otherwise their split is unknown
+		concurrency := 0
+		if len(process.Weights) == 1 {
+			concurrency = process.Concurrency
+		}
+		for i, weights := range process.Weights {
+			name := "default"
+			if i > 0 {
+				name = "capsule " + strconv.Itoa(i+1)
+			}
+			queues := orderedQueues(weights, process.Queues)
+			process.Capsules = append(process.Capsules, Capsule{
+				Name:        name,
+				Concurrency: concurrency,
+				Mode:        capsuleMode(queues, weights),
+				Queues:      queues,
+				Weights:     weights,
+			})
+		}
+		return
+	}
+
+	if len(process.Queues) > 0 {
+		process.Capsules = []Capsule{queueCapsule(process.Queues, process.Concurrency)}
+	}
+}
+
+// queueCapsule builds the single capsule of processes before Sidekiq 7,
+// which list weighted queues once per weight.
+func queueCapsule(list []string, concurrency int) Capsule {
+	weights := make(map[string]int)
+	var queues []string
+	for _, q := range list {
+		if _, ok := weights[q]; !ok {
+			queues = append(queues, q)
+		}
+		weights[q]++
+	}
+	mode := CapsuleWeighted
+	if len(queues) == len(list) {
+		// Without repeated queues Sidekiq 6 uses strict ordering
+		mode = CapsuleStrict
+	}
+	return Capsule{
+		Name:        "default",
+		Concurrency: concurrency,
+		Mode:        mode,
+		Queues:      queues,
+		Weights:     weights,
+	}
+}
+
+// processCapsules returns the process capsules, falling back to a single
+// capsule built from the queue list.
+func processCapsules(process Process) []Capsule {
+	if len(process.Capsules) > 0 || len(process.Queues) == 0 {
+		return process.Capsules
+	}
+	return []Capsule{queueCapsule(process.Queues, process.Concurrency)}
+}
+
+// orderedQueues returns the queues of a weights map in process queue order.
+// JSON objects do not keep their order, but the process queue list does.
+func orderedQueues(weights map[string]int, order []string) []string {
+	queues := make([]string, 0, len(weights))
+	for _, q := range order {
+		if _, ok := weights[q]; ok && !slices.Contains(queues, q) {
+			queues = append(queues, q)
+		}
+	}
+	var rest []string
+	for q := range weights {
+		if !slices.Contains(queues, q) {
+			rest = append(rest, q)
+		}
+	}
+	slices.Sort(rest)
+	return append(queues, rest...)
+}
+
+func intMap(value any) map[string]int {
+	data, _ := value.(map[string]any)
+	result := make(map[string]int, len(data))
+	for k, v := range data {
+		if n, ok := v.(float64); ok {
+			result[k] = int(n)
+		}
+	}
+	return result
+}
+
+func uniqueStrings(values []any) []string {
+	result := make([]string, 0, len(values))
+	for _, v := range values {
+		if s, ok := v.(string); ok && !slices.Contains(result, s) {
+			result = append(result, s)
+		}
+	}
+	return result
+}
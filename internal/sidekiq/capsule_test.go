@@ -0,0 +1,81 @@
+package sidekiq
+
+import (
+	"math"
+	"slices"
+	"testing"
+)
+
+func TestParseCapsules(t *testing.T) {
+	tests := []struct {
+		name string
+		info string
+		want []Capsule
+	}{
+		{
+			name: "capsules",
+			info: `{"concurrency":15,"queues":["critical","default","single"],` +
+				`"capsules":{"single":{"concurrency":1,"mode":"strict","weights":{"single":0}},` +
+				`"default":{"concurrency":14,"weights":{"default":1,"critical":3}}}}`,
+			want: []Capsule{
+				{Name: "default", Concurrency: 14, Mode: CapsuleWeighted, Queues: []string{"critical", "default"}},
+				{Name: "single", Concurrency: 1, Mode: CapsuleStrict, Queues: []string{"single"}},
+			},
+		},
+		{
+			name: "weights",
+			info: `{"concurrency":10,"queues":["critical","default","low"],` +
+				`"weights":[{"critical":0,"default":0},{"low":1}]}`,
+			want: []Capsule{
+				{Name: "default", Concurrency: 0, Mode: CapsuleStrict, Queues: []string{"critical", "default"}},
+				{Name: "capsule 2", Concurrency: 0, Mode: CapsuleRandom, Queues: []string{"low"}},
+			},
+		},
+		{
+			name: "queues",
+			info: `{"concurrency":10,"queues":["critical","critical","default"]}`,
+			want: []Capsule{
+				{Name: "default", Concurrency: 10, Mode: CapsuleWeighted, Queues: []string{"critical", "default"}},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var process Process
+			parseProcessInfo(tt.info, &process)
+			if len(process.Capsules) != len(tt.want) {
+				t.Fatalf("capsules = %+v, want %d", process.Capsules, len(tt.want))
+			}
+			for i, want := range tt.want {
+				got := process.Capsules[i]
+				if got.Name != want.Name || got.Concurrency != want.Concurrency || got.Mode != want.Mode ||
+					!slices.Equal(got.Queues, want.Queues) {
+					t.Errorf("capsules[%d] = %+v, want %+v", i, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestCapsuleShare(t *testing.T) {
+	weighted := Capsule{Mode: CapsuleWeighted, Queues: []string{"critical", "default"}, Weights: map[string]int{"critical": 3, "default": 1}}
+	random := Capsule{Mode: CapsuleRandom, Queues: []string{"a", "b", "c", "d"}}
+	strict := Capsule{Mode: CapsuleStrict, Queues: []string{"a", "b"}}
+
+	tests := []struct {
+		capsule Capsule
+		queue   string
+		want    float64
+	}{
+		{capsule: weighted, queue: "critical", want: 0.75},
+		{capsule: weighted, queue: "default", want: 0.25},
+		{capsule: weighted, queue: "low", want: 0},
+		{capsule: random, queue: "b", want: 0.25},
+		{capsule: strict, queue: "b", want: 1},
+	}
+	for _, tt := range tests {
+		if got := tt.capsule.Share(tt.queue); math.Abs(got-tt.want) > 1e-9 {
+			t.Errorf("%s.Share(%q) = %v, want %v", tt.capsule.Mode, tt.queue, got, tt.want)
+		}
+	}
+}
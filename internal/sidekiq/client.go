@@ -27,19 +27,52 @@ type Stats struct {
 	Retries   int64
 	Scheduled int64
 	Dead      int64
+
+	Processes      int64
+	StaleProcesses int64
+}
+
+// StaleProcessAge is how long after its last heartbeat a process is
+// considered dead. Sidekiq beats every few seconds and lets the process hash
+// expire after a minute.
+const StaleProcessAge = 60 * time.Second
+
+// isStaleBeat reports whether a heartbeat timestamp is missing or older than
+// StaleProcessAge at now, the Redis server time (see Client.ServerTime).
+func isStaleBeat(beat float64, now time.Time) bool {
+	if beat <= 0 {
+		return true
+	}
+	return now.Sub(time.UnixMilli(int64(beat*1000))) > StaleProcessAge
 }
 
 // Process represents a Sidekiq worker process.
 type Process struct {
-	Identity    string   // hostname:pid:nonce (e.g., "be4860dbdb68:14:96908d62200c")
-	Hostname    string   // Parsed from identity (e.g., "be4860dbdb68")
-	PID         string   // Parsed from identity (e.g., "14")
-	Tag         string   // From info.tag (e.g., "myapp")
-	Concurrency int      // From info.concurrency
-	Busy        int      // From busy field (converted to int)
-	Queues      []string // From info.queues
-	RSS         int64    // From rss field in KB, convert to bytes (*1024)
-	StartedAt   int64    // From info.started_at (Unix timestamp)
+	Identity    string           // hostname:pid:nonce (e.g., "be4860dbdb68:14:96908d62200c")
+	Hostname    string           // Parsed from identity (e.g., "be4860dbdb68")
+	PID         string           // Parsed from identity (e.g., "14")
+	Tag         string           // From info.tag (e.g., "myapp")
+	Concurrency int              // From info.concurrency
+	Busy        int              // From busy field (converted to int)
+	Queues      []string         // From info.queues
+	RSS         int64            // From rss field in KB, convert to bytes (*1024)
+	StartedAt   int64            // From info.started_at (Unix timestamp)
+	Version     string           // From info.version (Sidekiq version)
+	Labels      []string         // From info.labels
+	Weights     []map[string]int // From info.weights, one map per capsule (Sidekiq 7+)
+	Capsules    []Capsule        // From info.capsules, derived from weights or queues when missing
+	Embedded    bool             // From info.embedded
+	Beat        float64          // From beat field (Unix timestamp of the last heartbeat)
+	Quiet       bool             // From quiet field
+	RTT         int64            // From rtt_us field (Redis round trip in microseconds)
+	Info        map[string]any   // The parsed info JSON, including unknown fields
+}
+
+// Stale reports whether the process missed its heartbeats, which usually
+// means it was killed without a chance to remove itself. now is the Redis
+// server time, so a local clock skewed from the workers' does not matter.
+func (p Process) Stale(now time.Time) bool {
+	return isStaleBeat(p.Beat, now)
 }
 
 // Job represents an active Sidekiq job (currently running).
@@ -54,6 +87,8 @@ type Job struct {
 type BusyData struct {
 	Processes []Process
 	Jobs      []Job
+	// Now is the Redis server time of the fetch, to judge heartbeats against.
+	Now time.Time
 }
 
 // Client is a Sidekiq API client.
@@ -84,6 +119,12 @@ func NewClient(redisURL string) (*Client, error) {
 	return &Client{redis: rdb}, nil
 }
 
+// Addr returns the Redis address and database (e.g. "localhost:6379/0").
+func (c *Client) Addr() string {
+	opts := c.redis.Options()
+	return fmt.Sprintf("%s/%d", opts.Addr, opts.DB)
+}
+
 // Close closes the Redis connection.
 func (c *Client) Close() error {
 	return c.redis.Close()
@@ -116,19 +157,28 @@ func (c *Client) GetStats(ctx context.Context) (Stats, error) {
 	if err != nil && err != redis.Nil {
 		return stats, err
 	}
+	now, err := c.ServerTime(ctx)
+	if err != nil {
+		return stats, err
+	}
 	var busy int64
 	for _, processKey := range processes {
-		// Get the "busy" field directly from the process hash
-		busyStr, err := c.redis.HGet(ctx, processKey, "busy").Result()
-		if err != nil && err != redis.Nil {
+		// Get the "busy" and "beat" fields directly from the process hash
+		fields, err := c.redis.HMGet(ctx, processKey, "busy", "beat").Result()
+		if err != nil || len(fields) < 2 {
 			continue
 		}
-		if err == nil {
-			busyCount, _ := strconv.ParseInt(busyStr, 10, 64)
+		if busyCount, ok := parseOptionalInt64(fields[0]); ok {
 			busy += busyCount
 		}
+		beat, _ := fields[1].(string)
+		beatTime, _ := strconv.ParseFloat(beat, 64)
+		if isStaleBeat(beatTime, now) {
+			stats.StaleProcesses++
+		}
 	}
 	stats.Busy = busy
+	stats.Processes = int64(len(processes))
 
 	// Get enqueued count by summing all queue sizes
 	queues, err := c.redis.SMembers(ctx, "queues").Result()
@@ -168,52 +218,142 @@ func (c *Client) GetStats(ctx context.Context) (Stats, error) {
 	return stats, nil
 }
 
-// GetBusyData fetches detailed process and active job information from Redis.
-func (c *Client) GetBusyData(ctx context.Context) (BusyData, error) {
-	var data BusyData
+// ServerTime returns the Redis server clock. Heartbeats are judged against
+// it rather than the local clock, which may be skewed from the workers'.
+func (c *Client) ServerTime(ctx context.Context) (time.Time, error) {
+	return c.redis.Time(ctx).Result()
+}
+
+// CleanupProcesses removes identities whose process hash expired from the
+// processes set, like Sidekiq's ProcessSet#cleanup. As in Sidekiq, it runs
+// at most once a minute across all clients and removes nothing otherwise.
+func (c *Client) CleanupProcesses(ctx context.Context) (int64, error) {
+	locked, err := c.redis.SetNX(ctx, "process_cleanup", "1", time.Minute).Result()
+	if err != nil || !locked {
+		return 0, err
+	}
 
-	// Get all process identities
 	processes, err := c.redis.SMembers(ctx, "processes").Result()
 	if err != nil && err != redis.Nil {
-		return data, err
+		return 0, err
+	}
+	if len(processes) == 0 {
+		return 0, nil
 	}
 
-	// Fetch each process details
-	for _, identity := range processes {
-		// Get process hash fields
-		fields, err := c.redis.HMGet(ctx, identity, "info", "busy", "rss").Result()
-		if err != nil {
-			continue
-		}
+	pipe := c.redis.Pipeline()
+	infos := make([]*redis.StringCmd, len(processes))
+	for i, identity := range processes {
+		infos[i] = pipe.HGet(ctx, identity, "info")
+	}
+	if _, err := pipe.Exec(ctx); err != nil && err != redis.Nil {
+		return 0, err
+	}
 
-		// Check if we got results
-		if len(fields) < 3 {
-			continue
+	var gone []any
+	for i, cmd := range infos {
+		switch err := cmd.Err(); {
+		case err == redis.Nil:
+			gone = append(gone, processes[i])
+		case err != nil:
+			return 0, err
 		}
+	}
+	if len(gone) == 0 {
+		return 0, nil
+	}
+	return c.redis.SRem(ctx, "processes", gone...).Result()
+}
 
-		// Parse process info
-		process := Process{
-			Identity: identity,
+// GetProcesses fetches all processes without their running jobs.
+func (c *Client) GetProcesses(ctx context.Context) ([]Process, error) {
+	identities, err := c.redis.SMembers(ctx, "processes").Result()
+	if err != nil && err != redis.Nil {
+		return nil, err
+	}
+	processes := make([]Process, 0, len(identities))
+	for _, identity := range identities {
+		if process, ok := c.getProcess(ctx, identity); ok {
+			processes = append(processes, process)
 		}
+	}
+	return processes, nil
+}
 
-		// Parse identity to extract hostname and PID (format: hostname:pid:nonce)
-		parts := strings.Split(identity, ":")
-		if len(parts) >= 2 {
-			process.Hostname = parts[0]
-			process.PID = parts[1]
-		}
+// getProcess fetches and parses a process hash. It reports false when the
+// hash could not be read.
+func (c *Client) getProcess(ctx context.Context, identity string) (Process, bool) {
+	// Get process hash fields
+	fields, err := c.redis.HMGet(ctx, identity, "info", "busy", "rss", "beat", "quiet", "rtt_us").Result()
+	if err != nil {
+		return Process{}, false
+	}
 
-		// Parse info JSON
-		parseProcessInfo(fields[0], &process)
+	// Check if we got results
+	if len(fields) < 6 {
+		return Process{}, false
+	}
 
-		// Parse busy count
-		if busyCount, ok := parseOptionalInt64(fields[1]); ok {
-			process.Busy = int(busyCount)
-		}
+	// Parse process info
+	process := Process{
+		Identity: identity,
+	}
+
+	// Parse identity to extract hostname and PID (format: hostname:pid:nonce)
+	parts := strings.Split(identity, ":")
+	if len(parts) >= 2 {
+		process.Hostname = parts[0]
+		process.PID = parts[1]
+	}
+
+	// Parse info JSON
+	parseProcessInfo(fields[0], &process)
+
+	// Parse busy count
+	if busyCount, ok := parseOptionalInt64(fields[1]); ok {
+		process.Busy = int(busyCount)
+	}
+
+	// Parse RSS (in KB, convert to bytes)
+	if rss, ok := parseOptionalInt64(fields[2]); ok {
+		process.RSS = rss * 1024
+	}
+
+	// Parse heartbeat (float Unix timestamp), quiet flag, and Redis RTT
+	if beat, ok := fields[3].(string); ok {
+		process.Beat, _ = strconv.ParseFloat(beat, 64)
+	}
+	if quiet, ok := fields[4].(string); ok {
+		process.Quiet = quiet == "true"
+	}
+	if rtt, ok := parseOptionalInt64(fields[5]); ok {
+		process.RTT = rtt
+	}
+
+	return process, true
+}
+
+// GetBusyData fetches detailed process and active job information from Redis.
+func (c *Client) GetBusyData(ctx context.Context) (BusyData, error) {
+	var data BusyData
+
+	now, err := c.ServerTime(ctx)
+	if err != nil {
+		return data, err
+	}
+	data.Now = now
 
-		// Parse RSS (in KB, convert to bytes)
-		if rss, ok := parseOptionalInt64(fields[2]); ok {
-			process.RSS = rss * 1024
+	// Get all process identities
+	processes, err := c.redis.SMembers(ctx, "processes").Result()
+	if err != nil && err != redis.Nil {
+		return data, err
+	}
+
+	// Fetch each process details
+	for _, identity := range processes {
+		process, ok := c.getProcess(ctx, identity)
+		if !ok {
+			continue
 		}
 
 		data.Processes = append(data.Processes, process)
@@ -268,6 +408,7 @@ func parseProcessInfo(field any, process *Process) {
 	if err := json.Unmarshal([]byte(infoStr), &info); err != nil {
 		return
 	}
+	process.Info = info
 
 	if concurrency, ok := info["concurrency"].(float64); ok {
 		process.Concurrency = int(concurrency)
@@ -287,6 +428,35 @@ func parseProcessInfo(field any, process *Process) {
 	if startedAt, ok := info["started_at"].(float64); ok {
 		process.StartedAt = int64(startedAt)
 	}
+	if version, ok := info["version"].(string); ok {
+		process.Version = version
+	}
+	if labels, ok := info["labels"].([]any); ok {
+		for _, l := range labels {
+			if label, ok := l.(string); ok {
+				process.Labels = append(process.Labels, label)
+			}
+		}
+	}
+	if weights, ok := info["weights"].([]any); ok {
+		for _, w := range weights {
+			capsule, ok := w.(map[string]any)
+			if !ok {
+				continue
+			}
+			queueWeights := make(map[string]int, len(capsule))
+			for queue, weight := range capsule {
+				if n, ok := weight.(float64); ok {
+					queueWeights[queue] = int(n)
+				}
+			}
+			process.Weights = append(process.Weights, queueWeights)
+		}
+	}
+	if embedded, ok := info["embedded"].(bool); ok {
+		process.Embedded = embedded
+	}
+	parseCapsules(info, process)
 }
 
 func parseOptionalInt64(field any) (int64, bool) {
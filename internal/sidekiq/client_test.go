@@ -0,0 +1,51 @@
+package sidekiq
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseProcessInfo(t *testing.T) {
+	info := `{"hostname":"web1","pid":14,"tag":"app","concurrency":10,"queues":["critical","default"],` +
+		`"weights":[{"critical":2,"default":1}],"labels":["reliable"],"version":"7.3.0","embedded":false,"custom":"x"}`
+
+	var process Process
+	parseProcessInfo(info, &process)
+
+	if process.Tag != "app" || process.Concurrency != 10 || process.Version != "7.3.0" {
+		t.Fatalf("process = %+v", process)
+	}
+	if len(process.Queues) != 2 || len(process.Labels) != 1 || process.Labels[0] != "reliable" {
+		t.Fatalf("queues = %v, labels = %v", process.Queues, process.Labels)
+	}
+	if len(process.Weights) != 1 || process.Weights[0]["critical"] != 2 {
+		t.Fatalf("weights = %v", process.Weights)
+	}
+	if process.Info["custom"] != "x" {
+		t.Fatalf("info = %v, want unknown fields kept", process.Info)
+	}
+
+	var empty Process
+	parseProcessInfo(nil, &empty)
+	if empty.Info != nil {
+		t.Fatalf("info = %v, want nil for a missing field", empty.Info)
+	}
+}
+
+func TestProcessStale(t *testing.T) {
+	now := time.Unix(1_700_000_000, 0)
+	tests := []struct {
+		beat float64
+		want bool
+	}{
+		{beat: 0, want: true},
+		{beat: float64(now.Unix()) - 5.5, want: false},
+		{beat: float64(now.Add(-StaleProcessAge).Unix()), want: false},
+		{beat: float64(now.Add(-StaleProcessAge).Unix()) - 1, want: true},
+	}
+	for _, tt := range tests {
+		if got := (Process{Beat: tt.beat}).Stale(now); got != tt.want {
+			t.Errorf("Process{Beat: %v}.Stale() = %v, want %v", tt.beat, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,98 @@
+package sidekiq
+
+import (
+	"cmp"
+	"slices"
+	"time"
+)
+
+// QueueCoverage describes the processes fetching jobs from a queue.
+type QueueCoverage struct {
+	Queue string
+	Size  int64
+	// Exists reports whether the queue is listed in the queues set.
+	Exists bool
+	// Processes lists identities of live processes listening to the queue.
+	Processes []string
+	// Concurrency is the total number of threads of capsules fetching from
+	// the queue. Capsules of unknown size count with all threads of their
+	// process, so the total is an upper bound for them.
+	Concurrency int
+	// Effective is the number of those threads expected to work on the
+	// queue while all queues have jobs, based on capsule weights.
+	Effective float64
+}
+
+// Orphaned reports whether the queue has jobs but no process listening.
+func (q QueueCoverage) Orphaned() bool {
+	return q.Size > 0 && len(q.Processes) == 0
+}
+
+// Unknown reports whether processes listen to a queue that does not exist,
+// which usually means a typo in the queue name.
+func (q QueueCoverage) Unknown() bool {
+	return !q.Exists && len(q.Processes) > 0
+}
+
+// BuildQueueCoverage maps existing queues with their sizes to the processes
+// listening to them. Queues only known from processes are appended, sorted
+// by name. Stale processes are skipped, as they no longer fetch jobs.
+func BuildQueueCoverage(sizes map[string]int64, processes []Process, now time.Time) []QueueCoverage {
+	names := make([]string, 0, len(sizes))
+	for name := range sizes {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	coverage := make([]QueueCoverage, len(names))
+	index := make(map[string]int, len(names))
+	for i, name := range names {
+		coverage[i] = QueueCoverage{Queue: name, Size: sizes[name], Exists: true}
+		index[name] = i
+	}
+
+	var unknown []QueueCoverage
+	for _, proc := range processes {
+		if proc.Stale(now) {
+			continue
+		}
+		// Queues of capsules of unknown size count the process threads once
+		unsized := make(map[string]bool)
+		for _, capsule := range processCapsules(proc) {
+			threads := capsule.Concurrency
+			if threads == 0 {
+				threads = proc.Concurrency
+			}
+			for _, queue := range capsule.Queues {
+				i, ok := index[queue]
+				if !ok {
+					i = len(coverage) + len(unknown)
+					index[queue] = i
+					unknown = append(unknown, QueueCoverage{Queue: queue})
+				}
+				var entry *QueueCoverage
+				if i < len(coverage) {
+					entry = &coverage[i]
+				} else {
+					entry = &unknown[i-len(coverage)]
+				}
+				if !slices.Contains(entry.Processes, proc.Identity) {
+					entry.Processes = append(entry.Processes, proc.Identity)
+				}
+				if capsule.Concurrency == 0 {
+					if unsized[queue] {
+						continue
+					}
+					unsized[queue] = true
+				}
+				entry.Concurrency += threads
+				entry.Effective += capsule.Share(queue) * float64(threads)
+			}
+		}
+	}
+
+	slices.SortFunc(unknown, func(a, b QueueCoverage) int {
+		return cmp.Compare(a.Queue, b.Queue)
+	})
+	return append(coverage, unknown...)
+}
@@ -0,0 +1,74 @@
+package sidekiq
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestBuildQueueCoverage(t *testing.T) {
+	now := time.Unix(1_700_000_000, 0)
+	beat := float64(now.Unix())
+	processes := []Process{
+		{Identity: "web1:1:a", Concurrency: 10, Beat: beat, Queues: []string{"critical", "critical", "default"}},
+		{Identity: "web2:1:b", Concurrency: 5, Beat: beat, Queues: []string{"default", "mailer"}},
+		{Identity: "web3:1:c", Concurrency: 20, Queues: []string{"low"}}, // stale
+	}
+	sizes := map[string]int64{"critical": 0, "default": 3, "low": 7}
+
+	coverage := BuildQueueCoverage(sizes, processes, now)
+	if len(coverage) != 4 {
+		t.Fatalf("len(coverage) = %d, want 4: %+v", len(coverage), coverage)
+	}
+
+	byQueue := make(map[string]QueueCoverage)
+	for _, queue := range coverage {
+		byQueue[queue.Queue] = queue
+	}
+	if critical := byQueue["critical"]; len(critical.Processes) != 1 || critical.Concurrency != 10 {
+		t.Fatalf("critical = %+v, want one process with 10 threads", critical)
+	}
+	if critical := byQueue["critical"]; math.Abs(critical.Effective-20.0/3) > 1e-9 {
+		t.Fatalf("critical.Effective = %v, want two thirds of 10 threads", critical.Effective)
+	}
+	if def := byQueue["default"]; len(def.Processes) != 2 || def.Concurrency != 15 || def.Orphaned() {
+		t.Fatalf("default = %+v, want two processes with 15 threads", def)
+	}
+	if def := byQueue["default"]; math.Abs(def.Effective-(10.0/3+5)) > 1e-9 {
+		t.Fatalf("default.Effective = %v, want a third of 10 threads plus 5 strict threads", def.Effective)
+	}
+	if low := byQueue["low"]; !low.Orphaned() {
+		t.Fatalf("low = %+v, want orphaned", low)
+	}
+	if mailer := byQueue["mailer"]; !mailer.Unknown() || mailer.Exists {
+		t.Fatalf("mailer = %+v, want unknown", mailer)
+	}
+	if coverage[3].Queue != "mailer" {
+		t.Fatalf("coverage[3] = %q, want unknown queues last", coverage[3].Queue)
+	}
+}
+
+func TestBuildQueueCoverageUnknownCapsuleConcurrency(t *testing.T) {
+	now := time.Unix(1_700_000_000, 0)
+	processes := []Process{{
+		Identity:    "web1:1:a",
+		Concurrency: 10,
+		Beat:        float64(now.Unix()),
+		Capsules: []Capsule{
+			{Name: "default", Mode: CapsuleStrict, Queues: []string{"default"}},
+			{Name: "capsule 2", Mode: CapsuleRandom, Queues: []string{"default", "low"}},
+		},
+	}}
+
+	coverage := BuildQueueCoverage(map[string]int64{"default": 1, "low": 1}, processes, now)
+	byQueue := make(map[string]QueueCoverage)
+	for _, queue := range coverage {
+		byQueue[queue.Queue] = queue
+	}
+	if def := byQueue["default"]; def.Concurrency != 10 || def.Effective != 10 {
+		t.Fatalf("default = %+v, want process threads counted once", def)
+	}
+	if low := byQueue["low"]; low.Concurrency != 10 || low.Effective != 5 {
+		t.Fatalf("low = %+v, want half of the process threads", low)
+	}
+}
@@ -22,7 +22,9 @@ type RedisInfo struct {
 type DashboardRealtime struct {
 	Stats     Stats
 	RedisInfo RedisInfo
-	FetchedAt time.Time
+	// QueueLatencies maps queue names to their latency in seconds.
+	QueueLatencies map[string]float64
+	FetchedAt      time.Time
 }
 
 // StatsHistory holds daily processed and failed counts.
@@ -66,10 +68,15 @@ func (c *Client) GetDashboardRealtime(ctx context.Context) (DashboardRealtime, e
 	if err != nil {
 		return DashboardRealtime{}, err
 	}
+	latencies, err := c.GetQueueLatencies(ctx)
+	if err != nil {
+		return DashboardRealtime{}, err
+	}
 	return DashboardRealtime{
-		Stats:     stats,
-		RedisInfo: redisInfo,
-		FetchedAt: time.Now(),
+		Stats:          stats,
+		RedisInfo:      redisInfo,
+		QueueLatencies: latencies,
+		FetchedAt:      time.Now(),
 	}, nil
 }
 
@@ -80,12 +87,21 @@ func (c *Client) GetStatsHistory(ctx context.Context, days int) (StatsHistory, e
 	}
 
 	endDate := time.Now().UTC()
-	dates := make([]time.Time, 0, days)
-	processedKeys := make([]string, 0, days)
-	failedKeys := make([]string, 0, days)
+	return c.GetStatsHistoryRange(ctx, endDate.AddDate(0, 0, -(days-1)), endDate)
+}
 
-	for i := days - 1; i >= 0; i-- {
-		date := endDate.AddDate(0, 0, -i)
+// GetStatsHistoryRange fetches per-day processed and failed stats for the
+// UTC dates from start to end, inclusive.
+func (c *Client) GetStatsHistoryRange(ctx context.Context, start, end time.Time) (StatsHistory, error) {
+	start = truncateDay(start)
+	end = truncateDay(end)
+	if end.Before(start) {
+		start, end = end, start
+	}
+
+	var dates []time.Time
+	var processedKeys, failedKeys []string
+	for date := start; !date.After(end); date = date.AddDate(0, 0, 1) {
 		dateStr := date.Format("2006-01-02")
 		dates = append(dates, date)
 		processedKeys = append(processedKeys, "stat:processed:"+dateStr)
@@ -115,6 +131,12 @@ func (c *Client) GetStatsHistory(ctx context.Context, days int) (StatsHistory, e
 	return history, nil
 }
 
+// truncateDay returns midnight UTC of the date t falls on in UTC.
+func truncateDay(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
 func parseInfo(raw string) map[string]string {
 	values := make(map[string]string)
 	for line := range strings.SplitSeq(raw, "\n") {
@@ -0,0 +1,184 @@
+package sidekiq
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// ErrJobNotFound is returned when the original job is no longer present,
+// for example because Sidekiq retried or removed it while it was being edited.
+var ErrJobNotFound = errors.New("job no longer exists")
+
+// replaceSortedEntryScript swaps a sorted set member, keeping its score.
+// KEYS[1] = sorted set, ARGV[1] = old member, ARGV[2] = new member, ARGV[3] = score.
+var replaceSortedEntryScript = redis.NewScript(`
+if redis.call("ZREM", KEYS[1], ARGV[1]) == 0 then
+  return 0
+end
+redis.call("ZADD", KEYS[1], ARGV[3], ARGV[2])
+return 1
+`)
+
+// enqueueSortedEntryScript moves a sorted set member into a queue.
+// KEYS[1] = sorted set, KEYS[2] = queue list, KEYS[3] = queues set,
+// ARGV[1] = old member, ARGV[2] = new payload, ARGV[3] = queue name.
+var enqueueSortedEntryScript = redis.NewScript(`
+if redis.call("ZREM", KEYS[1], ARGV[1]) == 0 then
+  return 0
+end
+redis.call("SADD", KEYS[3], ARGV[3])
+redis.call("LPUSH", KEYS[2], ARGV[2])
+return 1
+`)
+
+// replaceQueueJobScript replaces a queued job payload in place.
+// KEYS[1] = queue list, ARGV[1] = old payload, ARGV[2] = new payload.
+var replaceQueueJobScript = redis.NewScript(`
+local idx = redis.call("LPOS", KEYS[1], ARGV[1])
+if not idx then
+  return 0
+end
+redis.call("LSET", KEYS[1], idx, ARGV[2])
+return 1
+`)
+
+// ValidateJobPayload checks that value is a job payload Sidekiq can execute:
+// a JSON object with a class, an args array, and a jid.
+func ValidateJobPayload(value string) error {
+	var item map[string]any
+	if err := json.Unmarshal([]byte(value), &item); err != nil {
+		return fmt.Errorf("invalid JSON: %w", err)
+	}
+	if item == nil {
+		return errors.New("payload must be a JSON object")
+	}
+	if class, ok := item["class"].(string); !ok || class == "" {
+		return errors.New(`"class" must be a non-empty string`)
+	}
+	if _, ok := item["args"].([]any); !ok {
+		return errors.New(`"args" must be an array`)
+	}
+	if jid, ok := item["jid"].(string); !ok || jid == "" {
+		return errors.New(`"jid" must be a non-empty string`)
+	}
+	if queue, ok := item["queue"]; ok {
+		if name, ok := queue.(string); !ok || strings.TrimSpace(name) == "" {
+			return errors.New(`"queue" must be a non-empty string`)
+		}
+	}
+	return nil
+}
+
+// ReplaceSortedEntry atomically replaces entry in the sorted set with value,
+// keeping its score. Returns ErrJobNotFound if the entry is gone.
+func (c *Client) ReplaceSortedEntry(ctx context.Context, set SortedSet, entry *SortedEntry, value string) error {
+	if err := ValidateJobPayload(value); err != nil {
+		return err
+	}
+	replaced, err := replaceSortedEntryScript.Run(ctx, c.redis, []string{string(set)}, entry.Value(), value, entry.Score).Int()
+	if err != nil {
+		return err
+	}
+	if replaced == 0 {
+		return ErrJobNotFound
+	}
+	return nil
+}
+
+// EnqueueSortedEntry atomically removes entry from the sorted set and pushes
+// value onto its queue, like Sidekiq's SortedEntry#add_to_queue.
+// Returns ErrJobNotFound if the entry is gone.
+func (c *Client) EnqueueSortedEntry(ctx context.Context, set SortedSet, entry *SortedEntry, value string) error {
+	if err := ValidateJobPayload(value); err != nil {
+		return err
+	}
+
+	record := NewJobRecord(value, "")
+	queue := record.Queue()
+	if queue == "" {
+		queue = "default"
+	}
+	payload, err := withEnqueuedAt(record, time.Now())
+	if err != nil {
+		return err
+	}
+
+	keys := []string{string(set), "queue:" + queue, "queues"}
+	enqueued, err := enqueueSortedEntryScript.Run(ctx, c.redis, keys, entry.Value(), payload, queue).Int()
+	if err != nil {
+		return err
+	}
+	if enqueued == 0 {
+		return ErrJobNotFound
+	}
+	return nil
+}
+
+// ReplaceJob atomically replaces a queued job payload with value.
+// Returns ErrJobNotFound if the job has already been picked up.
+func (q *Queue) ReplaceJob(ctx context.Context, job *JobRecord, value string) error {
+	if err := ValidateJobPayload(value); err != nil {
+		return err
+	}
+	replaced, err := replaceQueueJobScript.Run(ctx, q.client.redis, []string{"queue:" + q.name}, job.Value(), value).Int()
+	if err != nil {
+		return err
+	}
+	if replaced == 0 {
+		return ErrJobNotFound
+	}
+	return nil
+}
+
+// withEnqueuedAt returns the payload with enqueued_at set to now, keeping the
+// timestamp format (float seconds or integer milliseconds) used by the job.
+// Only the enqueued_at value is rewritten, so the other fields keep their
+// order and exact encoding, including integers beyond float64 precision.
+func withEnqueuedAt(record *JobRecord, now time.Time) (string, error) {
+	var enqueuedAt any = float64(now.UnixNano()) / 1e9
+	if existing := record.EnqueuedAt(); existing > 1e12 || (existing == 0 && record.CreatedAt() > 1e12) {
+		enqueuedAt = now.UnixMilli()
+	}
+	encoded, err := json.Marshal(enqueuedAt)
+	if err != nil {
+		return "", err
+	}
+
+	value := record.Value()
+	dec := json.NewDecoder(strings.NewReader(value))
+	if tok, err := dec.Token(); err != nil || tok != json.Delim('{') {
+		return "", errors.New("payload must be a JSON object")
+	}
+	fields := 0
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return "", err
+		}
+		var raw json.RawMessage
+		if err := dec.Decode(&raw); err != nil {
+			return "", err
+		}
+		fields++
+		if tok == "enqueued_at" {
+			end := int(dec.InputOffset())
+			return value[:end-len(raw)] + string(encoded) + value[end:], nil
+		}
+	}
+	if _, err := dec.Token(); err != nil {
+		return "", err
+	}
+	// Add the field before the closing brace
+	closing := int(dec.InputOffset()) - 1
+	field := `"enqueued_at":` + string(encoded)
+	if fields > 0 {
+		field = "," + field
+	}
+	return value[:closing] + field + value[closing:], nil
+}
@@ -0,0 +1,110 @@
+package sidekiq
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestValidateJobPayload(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		wantErr string
+	}{
+		{name: "valid", value: `{"class":"MyJob","args":[1],"jid":"abc","queue":"default"}`},
+		{name: "invalid-json", value: `{"class":`, wantErr: "invalid JSON"},
+		{name: "not-object", value: `[1,2]`, wantErr: "invalid JSON"},
+		{name: "null", value: `null`, wantErr: "JSON object"},
+		{name: "missing-class", value: `{"args":[],"jid":"abc"}`, wantErr: `"class"`},
+		{name: "empty-class", value: `{"class":"","args":[],"jid":"abc"}`, wantErr: `"class"`},
+		{name: "args-not-array", value: `{"class":"MyJob","args":{},"jid":"abc"}`, wantErr: `"args"`},
+		{name: "missing-jid", value: `{"class":"MyJob","args":[]}`, wantErr: `"jid"`},
+		{name: "blank-queue", value: `{"class":"MyJob","args":[],"jid":"abc","queue":" "}`, wantErr: `"queue"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateJobPayload(tt.value)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("ValidateJobPayload() error = %v, want nil", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("ValidateJobPayload() error = %v, want containing %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestWithEnqueuedAt(t *testing.T) {
+	now := time.Unix(1_700_000_000, 500_000_000)
+
+	tests := []struct {
+		name  string
+		value string
+		want  float64
+	}{
+		{name: "seconds", value: `{"enqueued_at":1600000000.25}`, want: 1_700_000_000.5},
+		{name: "milliseconds", value: `{"enqueued_at":1600000000250}`, want: 1_700_000_000_500},
+		{name: "created-milliseconds", value: `{"created_at":1600000000250}`, want: 1_700_000_000_500},
+		{name: "missing", value: `{}`, want: 1_700_000_000.5},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			payload, err := withEnqueuedAt(NewJobRecord(tt.value, ""), now)
+			if err != nil {
+				t.Fatalf("withEnqueuedAt() error = %v", err)
+			}
+			if got := NewJobRecord(payload, "").EnqueuedAt(); got != tt.want {
+				t.Fatalf("EnqueuedAt() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWithEnqueuedAt_KeepsPayload(t *testing.T) {
+	now := time.Unix(1_700_000_000, 500_000_000)
+
+	tests := []struct {
+		name  string
+		value string
+		want  string
+	}{
+		{
+			name:  "replace",
+			value: `{"jid":"abc","args":[9007199254740993,"<a&b>"],"enqueued_at": 1600000000.25 ,"queue":"default"}`,
+			want:  `{"jid":"abc","args":[9007199254740993,"<a&b>"],"enqueued_at": 1700000000.5 ,"queue":"default"}`,
+		},
+		{
+			name:  "append",
+			value: `{"jid":"abc","args":[9007199254740993]}`,
+			want:  `{"jid":"abc","args":[9007199254740993],"enqueued_at":1700000000.5}`,
+		},
+		{
+			name:  "nested-enqueued-at",
+			value: `{"args":[{"enqueued_at":1}]}`,
+			want:  `{"args":[{"enqueued_at":1}],"enqueued_at":1700000000.5}`,
+		},
+		{
+			name:  "empty",
+			value: `{ }`,
+			want:  `{ "enqueued_at":1700000000.5}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			payload, err := withEnqueuedAt(NewJobRecord(tt.value, ""), now)
+			if err != nil {
+				t.Fatalf("withEnqueuedAt() error = %v", err)
+			}
+			if payload != tt.want {
+				t.Fatalf("withEnqueuedAt() = %s, want %s", payload, tt.want)
+			}
+		})
+	}
+}
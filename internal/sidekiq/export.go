@@ -0,0 +1,63 @@
+package sidekiq
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+)
+
+// statsHistoryDay is the exported form of a single StatsHistory day.
+type statsHistoryDay struct {
+	Date      string `json:"date"`
+	Processed int64  `json:"processed"`
+	Failed    int64  `json:"failed"`
+}
+
+// WriteCSV writes the history as CSV with a date, processed, failed header.
+func (h StatsHistory) WriteCSV(w io.Writer) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write([]string{"date", "processed", "failed"}); err != nil {
+		return fmt.Errorf("write csv: %w", err)
+	}
+	for _, day := range h.days() {
+		record := []string{
+			day.Date,
+			strconv.FormatInt(day.Processed, 10),
+			strconv.FormatInt(day.Failed, 10),
+		}
+		if err := writer.Write(record); err != nil {
+			return fmt.Errorf("write csv: %w", err)
+		}
+	}
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		return fmt.Errorf("write csv: %w", err)
+	}
+	return nil
+}
+
+// WriteJSON writes the history as an indented JSON array of days.
+func (h StatsHistory) WriteJSON(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(h.days()); err != nil {
+		return fmt.Errorf("write json: %w", err)
+	}
+	return nil
+}
+
+func (h StatsHistory) days() []statsHistoryDay {
+	days := make([]statsHistoryDay, len(h.Dates))
+	for i, date := range h.Dates {
+		days[i] = statsHistoryDay{Date: date.UTC().Format("2006-01-02")}
+		if i < len(h.Processed) {
+			days[i].Processed = h.Processed[i]
+		}
+		if i < len(h.Failed) {
+			days[i].Failed = h.Failed[i]
+		}
+	}
+	return days
+}
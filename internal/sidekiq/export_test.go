@@ -0,0 +1,50 @@
+package sidekiq
+
+import (
+	"bytes"
+	"testing"
+	"time"
+)
+
+func testStatsHistory() StatsHistory {
+	day := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	return StatsHistory{
+		Dates:     []time.Time{day, day.AddDate(0, 0, 1)},
+		Processed: []int64{120, 80},
+		Failed:    []int64{3, 0},
+	}
+}
+
+func TestStatsHistory_WriteCSV(t *testing.T) {
+	var buf bytes.Buffer
+	if err := testStatsHistory().WriteCSV(&buf); err != nil {
+		t.Fatalf("WriteCSV() error = %v", err)
+	}
+	want := "date,processed,failed\n2026-03-01,120,3\n2026-03-02,80,0\n"
+	if buf.String() != want {
+		t.Fatalf("WriteCSV() = %q, want %q", buf.String(), want)
+	}
+}
+
+func TestStatsHistory_WriteJSON(t *testing.T) {
+	var buf bytes.Buffer
+	if err := testStatsHistory().WriteJSON(&buf); err != nil {
+		t.Fatalf("WriteJSON() error = %v", err)
+	}
+	want := `[
+  {
+    "date": "2026-03-01",
+    "processed": 120,
+    "failed": 3
+  },
+  {
+    "date": "2026-03-02",
+    "processed": 80,
+    "failed": 0
+  }
+]
+`
+	if buf.String() != want {
+		t.Fatalf("WriteJSON() = %q, want %q", buf.String(), want)
+	}
+}
@@ -0,0 +1,216 @@
+package sidekiq
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	// memoryUsageSamples is the number of nested elements MEMORY USAGE
+	// samples to estimate large lists, sorted sets, and hashes.
+	memoryUsageSamples = 100
+	// memoryPipelineSize is the number of keys measured per pipeline.
+	memoryPipelineSize = 500
+	// jobSampleSize is the number of payloads sampled per queue or set.
+	jobSampleSize = 200
+	// largestJobsCount is the number of largest payloads reported.
+	largestJobsCount = 10
+)
+
+// MemoryGroup is the memory used by a group of Sidekiq keys.
+type MemoryGroup struct {
+	Name  string
+	Keys  int
+	Bytes int64
+}
+
+// JobSize is the size of a sampled job payload.
+type JobSize struct {
+	Class  string
+	JID    string
+	Source string
+	Bytes  int
+}
+
+// MemoryReport breaks down memory used by Sidekiq keys.
+type MemoryReport struct {
+	// Groups are sorted by size, largest first.
+	Groups      []MemoryGroup
+	LargestJobs []JobSize
+	SampledJobs int
+	// UsedMemory is the total memory used by Redis.
+	UsedMemory int64
+}
+
+// TotalBytes returns the memory used by all measured keys.
+func (r MemoryReport) TotalBytes() int64 {
+	var total int64
+	for _, group := range r.Groups {
+		total += group.Bytes
+	}
+	return total
+}
+
+// memoryKeyGroup is a named group of keys to measure.
+type memoryKeyGroup struct {
+	name string
+	keys []string
+}
+
+// AnalyzeMemory measures memory used by queues, job sets, processes, and
+// stats with MEMORY USAGE, and samples the largest job payloads.
+// The analysis touches every Sidekiq key, so run it on demand only.
+func (c *Client) AnalyzeMemory(ctx context.Context) (MemoryReport, error) {
+	var report MemoryReport
+
+	queues, err := c.redis.SMembers(ctx, "queues").Result()
+	if err != nil && err != redis.Nil {
+		return report, err
+	}
+	slices.Sort(queues)
+	processes, err := c.redis.SMembers(ctx, "processes").Result()
+	if err != nil && err != redis.Nil {
+		return report, err
+	}
+	statKeys, err := c.scanKeys(ctx, "stat:*")
+	if err != nil {
+		return report, err
+	}
+
+	groups := make([]memoryKeyGroup, 0, len(queues)+6)
+	for _, queue := range queues {
+		groups = append(groups, memoryKeyGroup{name: "queue:" + queue, keys: []string{"queue:" + queue}})
+	}
+	workKeys := make([]string, len(processes))
+	for i, identity := range processes {
+		workKeys[i] = identity + ":work"
+	}
+	groups = append(groups,
+		memoryKeyGroup{name: string(RetrySet), keys: []string{string(RetrySet)}},
+		memoryKeyGroup{name: string(ScheduledSet), keys: []string{string(ScheduledSet)}},
+		memoryKeyGroup{name: string(DeadSet), keys: []string{string(DeadSet)}},
+		memoryKeyGroup{name: "processes", keys: append([]string{"processes"}, processes...)},
+		memoryKeyGroup{name: "work", keys: workKeys},
+		memoryKeyGroup{name: "stats", keys: statKeys},
+	)
+
+	for _, group := range groups {
+		measured, bytes, err := c.memoryUsage(ctx, group.keys)
+		if err != nil {
+			return report, fmt.Errorf("measure %s: %w", group.name, err)
+		}
+		report.Groups = append(report.Groups, MemoryGroup{Name: group.name, Keys: measured, Bytes: bytes})
+	}
+	slices.SortStableFunc(report.Groups, func(a, b MemoryGroup) int {
+		return cmp.Compare(b.Bytes, a.Bytes)
+	})
+
+	jobs, err := c.sampleJobSizes(ctx, queues)
+	if err != nil {
+		return report, fmt.Errorf("sample jobs: %w", err)
+	}
+	report.SampledJobs = len(jobs)
+	report.LargestJobs = largestJobs(jobs, largestJobsCount)
+
+	raw, err := c.redis.Info(ctx, "memory").Result()
+	if err != nil && err != redis.Nil {
+		return report, err
+	}
+	report.UsedMemory, _ = strconv.ParseInt(parseInfo(raw)["used_memory"], 10, 64)
+
+	return report, nil
+}
+
+// scanKeys returns all keys matching the pattern.
+func (c *Client) scanKeys(ctx context.Context, match string) ([]string, error) {
+	var keys []string
+	iter := c.redis.Scan(ctx, 0, match, sortedSetScanCount).Iterator()
+	for iter.Next(ctx) {
+		keys = append(keys, iter.Val())
+	}
+	if err := iter.Err(); err != nil {
+		return nil, err
+	}
+	return keys, nil
+}
+
+// memoryUsage returns the number of existing keys and their total size.
+func (c *Client) memoryUsage(ctx context.Context, keys []string) (int, int64, error) {
+	var (
+		count int
+		total int64
+	)
+	for chunk := range slices.Chunk(keys, memoryPipelineSize) {
+		pipe := c.redis.Pipeline()
+		cmds := make([]*redis.IntCmd, len(chunk))
+		for i, key := range chunk {
+			cmds[i] = pipe.MemoryUsage(ctx, key, memoryUsageSamples)
+		}
+		if _, err := pipe.Exec(ctx); err != nil && err != redis.Nil {
+			return 0, 0, err
+		}
+		for _, cmd := range cmds {
+			bytes, err := cmd.Result()
+			if err == redis.Nil {
+				continue
+			}
+			if err != nil {
+				return 0, 0, err
+			}
+			count++
+			total += bytes
+		}
+	}
+	return count, total, nil
+}
+
+// sampleJobSizes measures payloads from the head of each queue and the
+// retry, scheduled, and dead sets.
+func (c *Client) sampleJobSizes(ctx context.Context, queues []string) ([]JobSize, error) {
+	pipe := c.redis.Pipeline()
+	sources := make([]string, 0, len(queues)+3)
+	cmds := make([]*redis.StringSliceCmd, 0, len(queues)+3)
+	for _, queue := range queues {
+		sources = append(sources, "queue:"+queue)
+		cmds = append(cmds, pipe.LRange(ctx, "queue:"+queue, 0, jobSampleSize-1))
+	}
+	for _, set := range []SortedSet{RetrySet, ScheduledSet, DeadSet} {
+		sources = append(sources, string(set))
+		cmds = append(cmds, pipe.ZRange(ctx, string(set), 0, jobSampleSize-1))
+	}
+	if _, err := pipe.Exec(ctx); err != nil && err != redis.Nil {
+		return nil, err
+	}
+
+	var jobs []JobSize
+	for i, cmd := range cmds {
+		values, err := cmd.Result()
+		if err != nil && err != redis.Nil {
+			return nil, err
+		}
+		for _, value := range values {
+			record := NewJobRecord(value, "")
+			jobs = append(jobs, JobSize{
+				Class:  record.DisplayClass(),
+				JID:    record.JID(),
+				Source: sources[i],
+				Bytes:  len(value),
+			})
+		}
+	}
+	return jobs, nil
+}
+
+// largestJobs returns up to n jobs with the largest payloads, largest first.
+func largestJobs(jobs []JobSize, n int) []JobSize {
+	sorted := slices.Clone(jobs)
+	slices.SortStableFunc(sorted, func(a, b JobSize) int {
+		return cmp.Compare(b.Bytes, a.Bytes)
+	})
+	return sorted[:min(n, len(sorted))]
+}
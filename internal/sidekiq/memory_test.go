@@ -0,0 +1,30 @@
+package sidekiq
+
+import "testing"
+
+func TestLargestJobs(t *testing.T) {
+	jobs := []JobSize{
+		{JID: "a", Bytes: 10},
+		{JID: "b", Bytes: 300},
+		{JID: "c", Bytes: 20},
+		{JID: "d", Bytes: 300},
+	}
+
+	got := largestJobs(jobs, 3)
+	if len(got) != 3 || got[0].JID != "b" || got[1].JID != "d" || got[2].JID != "c" {
+		t.Fatalf("largestJobs() = %+v, want b, d, c", got)
+	}
+	if jobs[0].JID != "a" {
+		t.Fatal("largestJobs() reordered its input")
+	}
+	if got := largestJobs(jobs[:1], 3); len(got) != 1 {
+		t.Fatalf("largestJobs() returned %d jobs, want 1", len(got))
+	}
+}
+
+func TestMemoryReportTotalBytes(t *testing.T) {
+	report := MemoryReport{Groups: []MemoryGroup{{Name: "retry", Bytes: 2048}, {Name: "stats", Bytes: 512}}}
+	if got := report.TotalBytes(); got != 2560 {
+		t.Fatalf("TotalBytes() = %d, want 2560", got)
+	}
+}
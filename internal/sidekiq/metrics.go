@@ -0,0 +1,188 @@
+package sidekiq
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// MetricsRetention is how long Sidekiq 7+ keeps per-minute job metrics.
+const MetricsRetention = 8 * time.Hour
+
+// HistogramLabels are the upper bounds of Sidekiq execution time histogram
+// buckets; the last bucket holds everything slower.
+var HistogramLabels = []string{
+	"20ms", "30ms", "45ms", "65ms", "100ms", "150ms", "225ms", "335ms", "500ms", "750ms",
+	"1.1s", "1.7s", "2.5s", "3.8s", "5.75s", "8.5s", "13s", "20s", "30s", "45s",
+	"65s", "100s", "150s", "225s", "335s", "Slow",
+}
+
+// JobMetrics are execution metrics of a job class over a time window.
+type JobMetrics struct {
+	Class string
+	// Processed counts every execution, including failed ones.
+	Processed int64
+	Failed    int64
+	// Milliseconds is the total execution time.
+	Milliseconds int64
+}
+
+// AvgMilliseconds returns the average execution time.
+func (m JobMetrics) AvgMilliseconds() float64 {
+	if m.Processed == 0 {
+		return 0
+	}
+	return float64(m.Milliseconds) / float64(m.Processed)
+}
+
+// metricsKey returns the key of the per-minute metrics hash, like
+// "j|20250102|3:4" for 03:04 UTC.
+func metricsKey(t time.Time) string {
+	t = t.UTC()
+	return fmt.Sprintf("j|%s|%d:%d", t.Format("20060102"), t.Hour(), t.Minute())
+}
+
+// histogramKey returns the key of the per-minute execution time histogram
+// of a job class, like "HardJob-02-03:4" for 03:04 UTC on the 2nd.
+func histogramKey(class string, t time.Time) string {
+	t = t.UTC()
+	return fmt.Sprintf("%s-%s:%d", class, t.Format("02-15"), t.Minute())
+}
+
+// metricsMinutes returns the start of every minute in the window ending at
+// now, latest first.
+func metricsMinutes(window time.Duration, now time.Time) []time.Time {
+	count := max(int(min(window, MetricsRetention)/time.Minute), 1)
+	minute := now.Truncate(time.Minute)
+	minutes := make([]time.Time, count)
+	for i := range minutes {
+		minutes[i] = minute.Add(-time.Duration(i) * time.Minute)
+	}
+	return minutes
+}
+
+// GetJobMetrics returns execution metrics per job class over the window
+// ending at now, sorted by total execution time, longest first. Metrics
+// are recorded by Sidekiq 7+ only, older versions return no classes.
+func (c *Client) GetJobMetrics(ctx context.Context, window time.Duration, now time.Time) ([]JobMetrics, error) {
+	minutes := metricsMinutes(window, now)
+	pipe := c.redis.Pipeline()
+	cmds := make([]*redis.MapStringStringCmd, len(minutes))
+	for i, minute := range minutes {
+		cmds[i] = pipe.HGetAll(ctx, metricsKey(minute))
+	}
+	if _, err := pipe.Exec(ctx); err != nil && err != redis.Nil {
+		return nil, fmt.Errorf("fetch job metrics: %w", err)
+	}
+
+	hashes := make([]map[string]string, 0, len(cmds))
+	for _, cmd := range cmds {
+		hash, err := cmd.Result()
+		if err != nil && err != redis.Nil {
+			return nil, fmt.Errorf("fetch job metrics: %w", err)
+		}
+		hashes = append(hashes, hash)
+	}
+	return aggregateJobMetrics(hashes), nil
+}
+
+// aggregateJobMetrics sums "<class>|p", "<class>|f", and "<class>|ms"
+// counters of per-minute metrics hashes by class.
+func aggregateJobMetrics(hashes []map[string]string) []JobMetrics {
+	index := make(map[string]int)
+	var metrics []JobMetrics
+	for _, hash := range hashes {
+		for field, value := range hash {
+			sep := strings.LastIndexByte(field, '|')
+			if sep <= 0 {
+				continue
+			}
+			n, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				continue
+			}
+			class := field[:sep]
+			i, ok := index[class]
+			if !ok {
+				i = len(metrics)
+				index[class] = i
+				metrics = append(metrics, JobMetrics{Class: class})
+			}
+			switch field[sep+1:] {
+			case "p":
+				metrics[i].Processed += int64(n)
+			case "f":
+				metrics[i].Failed += int64(n)
+			case "ms":
+				metrics[i].Milliseconds += int64(n)
+			}
+		}
+	}
+	slices.SortFunc(metrics, func(a, b JobMetrics) int {
+		if c := cmp.Compare(b.Milliseconds, a.Milliseconds); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.Class, b.Class)
+	})
+	return metrics
+}
+
+// GetJobHistogram returns execution counts of a job class per histogram
+// bucket (see HistogramLabels) over the window ending at now.
+func (c *Client) GetJobHistogram(ctx context.Context, class string, window time.Duration, now time.Time) ([]int64, error) {
+	fields := make([]any, 0, len(HistogramLabels)*3)
+	for i := range HistogramLabels {
+		fields = append(fields, "GET", "u16", "#"+strconv.Itoa(i))
+	}
+
+	minutes := metricsMinutes(window, now)
+	pipe := c.redis.Pipeline()
+	cmds := make([]*redis.IntSliceCmd, len(minutes))
+	for i, minute := range minutes {
+		cmds[i] = pipe.BitFieldRO(ctx, histogramKey(class, minute), fields...)
+	}
+	if _, err := pipe.Exec(ctx); err != nil && err != redis.Nil {
+		return nil, fmt.Errorf("fetch job histogram: %w", err)
+	}
+
+	buckets := make([]int64, len(HistogramLabels))
+	for _, cmd := range cmds {
+		counts, err := cmd.Result()
+		if err != nil && err != redis.Nil {
+			return nil, fmt.Errorf("fetch job histogram: %w", err)
+		}
+		for i, count := range counts {
+			if i < len(buckets) {
+				buckets[i] += count
+			}
+		}
+	}
+	return buckets, nil
+}
+
+// HistogramPercentile returns the index of the bucket holding the given
+// percentile (0-100) of executions, or -1 for an empty histogram.
+func HistogramPercentile(buckets []int64, percentile float64) int {
+	var total int64
+	for _, count := range buckets {
+		total += count
+	}
+	if total == 0 {
+		return -1
+	}
+	target := float64(total) * percentile / 100
+	var seen int64
+	for i, count := range buckets {
+		seen += count
+		if count > 0 && float64(seen) >= target {
+			return i
+		}
+	}
+	return len(buckets) - 1
+}
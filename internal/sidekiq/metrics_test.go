@@ -0,0 +1,65 @@
+package sidekiq
+
+import (
+	"testing"
+	"time"
+)
+
+func TestMetricsKeys(t *testing.T) {
+	now := time.Date(2025, 1, 2, 3, 4, 59, 0, time.UTC)
+	if got := metricsKey(now); got != "j|20250102|3:4" {
+		t.Errorf("metricsKey() = %q, want j|20250102|3:4", got)
+	}
+	if got := histogramKey("HardJob", now); got != "HardJob-02-03:4" {
+		t.Errorf("histogramKey() = %q, want HardJob-02-03:4", got)
+	}
+
+	minutes := metricsMinutes(time.Hour, now)
+	if len(minutes) != 60 || !minutes[0].Equal(now.Truncate(time.Minute)) || !minutes[59].Equal(time.Date(2025, 1, 2, 2, 5, 0, 0, time.UTC)) {
+		t.Errorf("metricsMinutes() = %d minutes from %v to %v", len(minutes), minutes[0], minutes[len(minutes)-1])
+	}
+	if got := len(metricsMinutes(24*time.Hour, now)); got != 480 {
+		t.Errorf("len(metricsMinutes(24h)) = %d, want retention of 480 minutes", got)
+	}
+}
+
+func TestAggregateJobMetrics(t *testing.T) {
+	metrics := aggregateJobMetrics([]map[string]string{
+		{"FastJob|p": "10", "FastJob|ms": "50", "SlowJob|p": "2", "SlowJob|f": "1", "SlowJob|ms": "4000"},
+		{"FastJob|p": "5", "FastJob|f": "2", "FastJob|ms": "25", "Mailer|with|pipes|p": "1", "junk": "1"},
+	})
+	if len(metrics) != 3 {
+		t.Fatalf("metrics = %+v, want 3 classes", metrics)
+	}
+	if slow := metrics[0]; slow.Class != "SlowJob" || slow.Processed != 2 || slow.Failed != 1 || slow.AvgMilliseconds() != 2000 {
+		t.Errorf("metrics[0] = %+v, want SlowJob first", slow)
+	}
+	if fast := metrics[1]; fast.Class != "FastJob" || fast.Processed != 15 || fast.Failed != 2 || fast.Milliseconds != 75 {
+		t.Errorf("metrics[1] = %+v, want FastJob summed", fast)
+	}
+	if metrics[2].Class != "Mailer|with|pipes" {
+		t.Errorf("metrics[2].Class = %q, want the field up to the last pipe", metrics[2].Class)
+	}
+}
+
+func TestHistogramPercentile(t *testing.T) {
+	buckets := []int64{0, 50, 40, 0, 9, 1}
+	tests := []struct {
+		percentile float64
+		want       int
+	}{
+		{percentile: 0, want: 1},
+		{percentile: 50, want: 1},
+		{percentile: 90, want: 2},
+		{percentile: 99, want: 4},
+		{percentile: 100, want: 5},
+	}
+	for _, tt := range tests {
+		if got := HistogramPercentile(buckets, tt.percentile); got != tt.want {
+			t.Errorf("HistogramPercentile(p%v) = %d, want %d", tt.percentile, got, tt.want)
+		}
+	}
+	if got := HistogramPercentile(make([]int64, 3), 50); got != -1 {
+		t.Errorf("HistogramPercentile(empty) = %d, want -1", got)
+	}
+}
@@ -3,6 +3,7 @@ package sidekiq
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"sort"
 	"time"
 
@@ -58,12 +59,54 @@ func (q *Queue) Size(ctx context.Context) (int64, error) {
 // Mirrors Sidekiq::Queue#latency.
 func (q *Queue) Latency(ctx context.Context) (float64, error) {
 	entry, err := q.client.redis.LIndex(ctx, "queue:"+q.name, -1).Result()
-	if err == redis.Nil || entry == "" {
+	if err == redis.Nil {
 		return 0.0, nil
 	}
 	if err != nil {
 		return 0.0, err
 	}
+	return parseLatency(entry, time.Now())
+}
+
+// GetQueueLatencies fetches the latency of every known queue in seconds,
+// reading the oldest job of all queues in one pipeline.
+func (c *Client) GetQueueLatencies(ctx context.Context) (map[string]float64, error) {
+	queues, err := c.GetQueues(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	pipe := c.redis.Pipeline()
+	cmds := make([]*redis.StringCmd, len(queues))
+	for i, queue := range queues {
+		cmds[i] = pipe.LIndex(ctx, "queue:"+queue.name, -1)
+	}
+	if _, err := pipe.Exec(ctx); err != nil && err != redis.Nil {
+		return nil, fmt.Errorf("fetch queue latencies: %w", err)
+	}
+
+	now := time.Now()
+	latencies := make(map[string]float64, len(queues))
+	for i, cmd := range cmds {
+		entry, err := cmd.Result()
+		if err != nil && err != redis.Nil {
+			return nil, fmt.Errorf("fetch queue latencies: %w", err)
+		}
+		latency, err := parseLatency(entry, now)
+		if err != nil {
+			return nil, fmt.Errorf("parse latency of queue %s: %w", queues[i].name, err)
+		}
+		latencies[queues[i].name] = latency
+	}
+	return latencies, nil
+}
+
+// parseLatency returns the seconds since the oldest job entry of a queue
+// was enqueued, or zero for an empty queue.
+func parseLatency(entry string, now time.Time) (float64, error) {
+	if entry == "" {
+		return 0.0, nil
+	}
 
 	var jobData map[string]any
 	if err := json.Unmarshal([]byte(entry), &jobData); err != nil {
@@ -82,11 +125,11 @@ func (q *Queue) Latency(ctx context.Context) (float64, error) {
 	var latency float64
 	if enqueuedAt > 1e12 {
 		// New format: milliseconds
-		nowMs := float64(time.Now().UnixMilli())
+		nowMs := float64(now.UnixMilli())
 		latency = (nowMs - enqueuedAt) / 1000.0
 	} else {
 		// Old format: seconds
-		nowSec := float64(time.Now().Unix())
+		nowSec := float64(now.Unix())
 		latency = nowSec - enqueuedAt
 	}
 
@@ -0,0 +1,34 @@
+package sidekiq
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseLatency(t *testing.T) {
+	now := time.Unix(1_700_000_100, 0)
+	tests := []struct {
+		name    string
+		entry   string
+		want    float64
+		wantErr bool
+	}{
+		{name: "empty queue", entry: "", want: 0},
+		{name: "seconds", entry: `{"enqueued_at":1700000000.0}`, want: 100},
+		{name: "milliseconds", entry: `{"enqueued_at":1700000000000}`, want: 100},
+		{name: "future", entry: `{"enqueued_at":1700000200}`, want: 0},
+		{name: "no enqueued_at", entry: `{"class":"HardJob"}`, want: 0},
+		{name: "malformed", entry: `{"class":`, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseLatency(tt.entry, now)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseLatency() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Fatalf("parseLatency() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,161 @@
+package sidekiq
+
+import (
+	"context"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// RecommendedEvictionPolicy is the maxmemory-policy Sidekiq requires:
+// any other policy lets Redis silently drop jobs under memory pressure.
+const RecommendedEvictionPolicy = "noeviction"
+
+// RedisHealth holds Redis INFO fields describing server health.
+type RedisHealth struct {
+	Version       string
+	Mode          string
+	UptimeSeconds int64
+
+	ConnectedClients int64
+	BlockedClients   int64
+
+	UsedMemory          int64
+	UsedMemoryHuman     string
+	UsedMemoryRSSHuman  string
+	UsedMemoryPeakHuman string
+	FragmentationRatio  float64
+	MaxMemory           int64
+	MaxMemoryHuman      string
+	MaxMemoryPolicy     string
+
+	OpsPerSec      int64
+	KeyspaceHits   int64
+	KeyspaceMisses int64
+	EvictedKeys    int64
+	ExpiredKeys    int64
+
+	Keyspace []KeyspaceDB
+
+	RDBLastSaveTime      int64
+	RDBLastBgsaveStatus  string
+	RDBChangesSinceSave  int64
+	RDBBgsaveInProgress  bool
+	AOFEnabled           bool
+	AOFLastWriteStatus   string
+	AOFRewriteInProgress bool
+
+	Role              string
+	ConnectedReplicas int64
+	MasterHost        string
+	MasterPort        string
+	MasterLinkStatus  string
+}
+
+// KeyspaceDB holds key counts of a single Redis database.
+type KeyspaceDB struct {
+	DB      string
+	Keys    int64
+	Expires int64
+}
+
+// HitRatio returns keyspace hits as a fraction of lookups, and false when
+// there were no lookups yet.
+func (h RedisHealth) HitRatio() (float64, bool) {
+	total := h.KeyspaceHits + h.KeyspaceMisses
+	if total == 0 {
+		return 0, false
+	}
+	return float64(h.KeyspaceHits) / float64(total), true
+}
+
+// EvictionSafe reports whether Redis is configured to never evict keys.
+func (h RedisHealth) EvictionSafe() bool {
+	return h.MaxMemoryPolicy == "" || h.MaxMemoryPolicy == RecommendedEvictionPolicy
+}
+
+// GetRedisHealth fetches Redis INFO sections used by the Redis view.
+func (c *Client) GetRedisHealth(ctx context.Context) (RedisHealth, error) {
+	raw, err := c.redis.Info(ctx, "server", "clients", "memory", "persistence", "stats", "replication", "keyspace").Result()
+	if err != nil && err != redis.Nil {
+		return RedisHealth{}, err
+	}
+	return parseRedisHealth(raw), nil
+}
+
+func parseRedisHealth(raw string) RedisHealth {
+	info := parseInfo(raw)
+	intValue := func(key string) int64 {
+		n, _ := strconv.ParseInt(info[key], 10, 64)
+		return n
+	}
+
+	health := RedisHealth{
+		Version:       info["redis_version"],
+		Mode:          info["redis_mode"],
+		UptimeSeconds: intValue("uptime_in_seconds"),
+
+		ConnectedClients: intValue("connected_clients"),
+		BlockedClients:   intValue("blocked_clients"),
+
+		UsedMemory:          intValue("used_memory"),
+		UsedMemoryHuman:     info["used_memory_human"],
+		UsedMemoryRSSHuman:  info["used_memory_rss_human"],
+		UsedMemoryPeakHuman: info["used_memory_peak_human"],
+		MaxMemory:           intValue("maxmemory"),
+		MaxMemoryHuman:      info["maxmemory_human"],
+		MaxMemoryPolicy:     info["maxmemory_policy"],
+
+		OpsPerSec:      intValue("instantaneous_ops_per_sec"),
+		KeyspaceHits:   intValue("keyspace_hits"),
+		KeyspaceMisses: intValue("keyspace_misses"),
+		EvictedKeys:    intValue("evicted_keys"),
+		ExpiredKeys:    intValue("expired_keys"),
+
+		RDBLastSaveTime:      intValue("rdb_last_save_time"),
+		RDBLastBgsaveStatus:  info["rdb_last_bgsave_status"],
+		RDBChangesSinceSave:  intValue("rdb_changes_since_last_save"),
+		RDBBgsaveInProgress:  info["rdb_bgsave_in_progress"] == "1",
+		AOFEnabled:           info["aof_enabled"] == "1",
+		AOFLastWriteStatus:   info["aof_last_write_status"],
+		AOFRewriteInProgress: info["aof_rewrite_in_progress"] == "1",
+
+		Role:              info["role"],
+		ConnectedReplicas: intValue("connected_slaves"),
+		MasterHost:        info["master_host"],
+		MasterPort:        info["master_port"],
+		MasterLinkStatus:  info["master_link_status"],
+	}
+	health.FragmentationRatio, _ = strconv.ParseFloat(info["mem_fragmentation_ratio"], 64)
+
+	// Keyspace lines look like "db0:keys=12,expires=3,avg_ttl=0"
+	for name, value := range info {
+		if !strings.HasPrefix(name, "db") {
+			continue
+		}
+		if _, err := strconv.Atoi(name[2:]); err != nil {
+			continue
+		}
+		db := KeyspaceDB{DB: name}
+		for field := range strings.SplitSeq(value, ",") {
+			k, v, _ := strings.Cut(field, "=")
+			n, _ := strconv.ParseInt(v, 10, 64)
+			switch k {
+			case "keys":
+				db.Keys = n
+			case "expires":
+				db.Expires = n
+			}
+		}
+		health.Keyspace = append(health.Keyspace, db)
+	}
+	slices.SortFunc(health.Keyspace, func(a, b KeyspaceDB) int {
+		an, _ := strconv.Atoi(a.DB[2:])
+		bn, _ := strconv.Atoi(b.DB[2:])
+		return an - bn
+	})
+
+	return health
+}
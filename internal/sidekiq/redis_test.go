@@ -0,0 +1,83 @@
+package sidekiq
+
+import "testing"
+
+const testRedisInfo = `# Server
+redis_version:7.2.4
+redis_mode:standalone
+uptime_in_seconds:90061
+
+# Clients
+connected_clients:12
+blocked_clients:4
+
+# Memory
+used_memory:1048576
+used_memory_human:1.00M
+used_memory_rss_human:2.00M
+used_memory_peak_human:3.00M
+maxmemory:0
+maxmemory_human:0B
+maxmemory_policy:allkeys-lru
+mem_fragmentation_ratio:1.85
+
+# Persistence
+rdb_changes_since_last_save:17
+rdb_bgsave_in_progress:0
+rdb_last_save_time:1700000000
+rdb_last_bgsave_status:ok
+aof_enabled:1
+aof_rewrite_in_progress:0
+aof_last_write_status:ok
+
+# Stats
+instantaneous_ops_per_sec:250
+expired_keys:5
+evicted_keys:2
+keyspace_hits:90
+keyspace_misses:10
+
+# Replication
+role:master
+connected_slaves:1
+
+# Keyspace
+db10:keys=3,expires=0,avg_ttl=0
+db0:keys=120,expires=7,avg_ttl=3600
+`
+
+func TestParseRedisHealth(t *testing.T) {
+	health := parseRedisHealth(testRedisInfo)
+
+	if health.Version != "7.2.4" || health.UptimeSeconds != 90061 {
+		t.Fatalf("server = %q/%d, want 7.2.4/90061", health.Version, health.UptimeSeconds)
+	}
+	if health.BlockedClients != 4 || health.ConnectedClients != 12 {
+		t.Fatalf("clients = %d/%d, want 12/4", health.ConnectedClients, health.BlockedClients)
+	}
+	if health.FragmentationRatio != 1.85 || health.MaxMemoryPolicy != "allkeys-lru" {
+		t.Fatalf("memory = %v/%q", health.FragmentationRatio, health.MaxMemoryPolicy)
+	}
+	if health.EvictionSafe() {
+		t.Fatal("EvictionSafe() = true, want false for allkeys-lru")
+	}
+	if ratio, ok := health.HitRatio(); !ok || ratio != 0.9 {
+		t.Fatalf("HitRatio() = %v, %v, want 0.9, true", ratio, ok)
+	}
+	if !health.AOFEnabled || health.RDBChangesSinceSave != 17 || health.Role != "master" || health.ConnectedReplicas != 1 {
+		t.Fatalf("persistence/replication = %+v", health)
+	}
+	if len(health.Keyspace) != 2 || health.Keyspace[0].DB != "db0" || health.Keyspace[0].Keys != 120 || health.Keyspace[1].DB != "db10" {
+		t.Fatalf("Keyspace = %+v, want db0 then db10", health.Keyspace)
+	}
+}
+
+func TestRedisHealth_Defaults(t *testing.T) {
+	health := parseRedisHealth("")
+	if !health.EvictionSafe() {
+		t.Fatal("EvictionSafe() = false, want true when the policy is unknown")
+	}
+	if _, ok := health.HitRatio(); ok {
+		t.Fatal("HitRatio() ok = true, want false without lookups")
+	}
+}
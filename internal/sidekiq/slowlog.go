@@ -0,0 +1,124 @@
+package sidekiq
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// SlowlogEntry is a command recorded by Redis SLOWLOG.
+type SlowlogEntry struct {
+	ID         int64
+	Time       time.Time
+	Duration   time.Duration
+	Args       []string
+	ClientAddr string
+	ClientName string
+}
+
+// LatencyEvent is the latest latency spike of an event from LATENCY LATEST.
+type LatencyEvent struct {
+	Event  string
+	Time   time.Time
+	Latest time.Duration
+	Max    time.Duration
+}
+
+// sidekiqKeys are keys Sidekiq uses by exact name.
+var sidekiqKeys = map[string]bool{
+	"queues":    true,
+	"processes": true,
+	"retry":     true,
+	"schedule":  true,
+	"dead":      true,
+}
+
+// sidekiqKeyPrefixes are prefixes of keys Sidekiq (and Sidekiq Pro) uses:
+// queue lists, stats, job metrics, and batches.
+var sidekiqKeyPrefixes = []string{"queue:", "stat:", "j|", "b-"}
+
+// IsSidekiqKey reports whether key looks like a key written by Sidekiq.
+func IsSidekiqKey(key string) bool {
+	if sidekiqKeys[key] || strings.HasSuffix(key, ":work") {
+		return true
+	}
+	for _, prefix := range sidekiqKeyPrefixes {
+		if strings.HasPrefix(key, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// Command returns the command line as recorded by Redis, on a single line.
+// Arguments with newlines or other control characters, such as Lua scripts
+// passed to EVAL, are quoted with their control characters escaped.
+func (e SlowlogEntry) Command() string {
+	args := make([]string, len(e.Args))
+	for i, arg := range e.Args {
+		args[i] = arg
+		if strings.IndexFunc(arg, func(r rune) bool { return !unicode.IsPrint(r) }) >= 0 {
+			args[i] = strconv.Quote(arg)
+		}
+	}
+	return strings.Join(args, " ")
+}
+
+// Client returns the client name, falling back to its address.
+func (e SlowlogEntry) Client() string {
+	if e.ClientName != "" {
+		return e.ClientName
+	}
+	return e.ClientAddr
+}
+
+// TouchesSidekiq reports whether any command argument is a Sidekiq key.
+func (e SlowlogEntry) TouchesSidekiq() bool {
+	for _, arg := range e.Args[min(1, len(e.Args)):] {
+		if IsSidekiqKey(arg) {
+			return true
+		}
+	}
+	return false
+}
+
+// GetSlowlog fetches up to count of the most recent SLOWLOG entries.
+func (c *Client) GetSlowlog(ctx context.Context, count int64) ([]SlowlogEntry, error) {
+	logs, err := c.redis.SlowLogGet(ctx, count).Result()
+	if err != nil {
+		return nil, err
+	}
+	entries := make([]SlowlogEntry, len(logs))
+	for i, log := range logs {
+		entries[i] = SlowlogEntry{
+			ID:         log.ID,
+			Time:       log.Time,
+			Duration:   log.Duration,
+			Args:       log.Args,
+			ClientAddr: log.ClientAddr,
+			ClientName: log.ClientName,
+		}
+	}
+	return entries, nil
+}
+
+// GetLatencyLatest fetches the latest latency spike per event.
+// Events are only recorded when latency-monitor-threshold is set.
+func (c *Client) GetLatencyLatest(ctx context.Context) ([]LatencyEvent, error) {
+	latencies, err := c.redis.Latency(ctx).Result()
+	if err != nil {
+		return nil, err
+	}
+	events := make([]LatencyEvent, len(latencies))
+	for i, latency := range latencies {
+		events[i] = LatencyEvent{
+			Event:  latency.Name,
+			Time:   latency.Time,
+			Latest: latency.Latest,
+			Max:    latency.Max,
+		}
+	}
+	return events, nil
+}
@@ -0,0 +1,59 @@
+package sidekiq
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestIsSidekiqKey(t *testing.T) {
+	tests := map[string]bool{
+		"queues":                    true,
+		"queue:default":             true,
+		"retry":                     true,
+		"schedule":                  true,
+		"dead":                      true,
+		"processes":                 true,
+		"stat:processed:2026-01-01": true,
+		"host:12:abc:work":          true,
+		"j|20260101|12:30":          true,
+		"b-3f2a":                    true,
+		"session:42":                false,
+		"cache:retry":               false,
+	}
+	for key, want := range tests {
+		if got := IsSidekiqKey(key); got != want {
+			t.Errorf("IsSidekiqKey(%q) = %v, want %v", key, got, want)
+		}
+	}
+}
+
+func TestSlowlogEntry(t *testing.T) {
+	entry := SlowlogEntry{Args: []string{"LRANGE", "queue:default", "0", "-1"}, ClientAddr: "10.0.0.1:5000"}
+	if !entry.TouchesSidekiq() {
+		t.Fatal("TouchesSidekiq() = false, want true")
+	}
+	if entry.Command() != "LRANGE queue:default 0 -1" || entry.Client() != "10.0.0.1:5000" {
+		t.Fatalf("Command() = %q, Client() = %q", entry.Command(), entry.Client())
+	}
+
+	// The command name itself is not a key
+	if (SlowlogEntry{Args: []string{"queues"}}).TouchesSidekiq() {
+		t.Fatal("TouchesSidekiq() = true for a bare command name")
+	}
+	if (SlowlogEntry{}).TouchesSidekiq() {
+		t.Fatal("TouchesSidekiq() = true for an empty entry")
+	}
+}
+
+func TestSlowlogEntryCommandEscapesControlCharacters(t *testing.T) {
+	script := "local jobs = redis.call('zrange', KEYS[1], 0, 9)\n\treturn jobs\r\n"
+	entry := SlowlogEntry{Args: []string{"EVAL", script, "1", "schedule"}}
+
+	want := `EVAL "local jobs = redis.call('zrange', KEYS[1], 0, 9)\n\treturn jobs\r\n" 1 schedule`
+	if got := entry.Command(); got != want {
+		t.Fatalf("Command() = %q, want %q", got, want)
+	}
+	if strings.ContainsAny(entry.Command(), "\n\r\t") {
+		t.Fatalf("Command() = %q, want a single line", entry.Command())
+	}
+}
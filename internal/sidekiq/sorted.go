@@ -2,6 +2,7 @@ package sidekiq
 
 import (
 	"context"
+	"fmt"
 	"sort"
 	"strconv"
 	"strings"
@@ -11,6 +12,18 @@ import (
 
 const sortedSetScanCount int64 = 100
 
+// SortedSet names a Sidekiq sorted set holding jobs.
+type SortedSet string
+
+const (
+	// RetrySet holds failed jobs waiting to be retried.
+	RetrySet SortedSet = "retry"
+	// ScheduledSet holds jobs scheduled for future execution.
+	ScheduledSet SortedSet = "schedule"
+	// DeadSet holds jobs that exhausted their retries.
+	DeadSet SortedSet = "dead"
+)
+
 // SortedEntry represents a job stored in a Sidekiq sorted set (dead, retry, schedule).
 // It embeds a JobRecord for the job data and adds the sorted set score (timestamp).
 type SortedEntry struct {
@@ -102,30 +115,47 @@ func (c *Client) scanSortedSetJobs(ctx context.Context, key, match string, rever
 
 // GetDeadJobs fetches dead jobs with pagination (newest first).
 func (c *Client) GetDeadJobs(ctx context.Context, start, count int) ([]*SortedEntry, int64, error) {
-	return c.getSortedSetJobs(ctx, "dead", start, count, true)
+	return c.getSortedSetJobs(ctx, string(DeadSet), start, count, true)
 }
 
 // ScanDeadJobs scans dead jobs using a match pattern (no paging).
 func (c *Client) ScanDeadJobs(ctx context.Context, match string) ([]*SortedEntry, error) {
-	return c.scanSortedSetJobs(ctx, "dead", match, true)
+	return c.scanSortedSetJobs(ctx, string(DeadSet), match, true)
 }
 
 // GetRetryJobs fetches retry jobs with pagination (earliest retry first).
 func (c *Client) GetRetryJobs(ctx context.Context, start, count int) ([]*SortedEntry, int64, error) {
-	return c.getSortedSetJobs(ctx, "retry", start, count, false)
+	return c.getSortedSetJobs(ctx, string(RetrySet), start, count, false)
 }
 
 // ScanRetryJobs scans retry jobs using a match pattern (no paging).
 func (c *Client) ScanRetryJobs(ctx context.Context, match string) ([]*SortedEntry, error) {
-	return c.scanSortedSetJobs(ctx, "retry", match, false)
+	return c.scanSortedSetJobs(ctx, string(RetrySet), match, false)
 }
 
 // GetScheduledJobs fetches scheduled jobs with pagination (earliest execution time first).
 func (c *Client) GetScheduledJobs(ctx context.Context, start, count int) ([]*SortedEntry, int64, error) {
-	return c.getSortedSetJobs(ctx, "schedule", start, count, false)
+	return c.getSortedSetJobs(ctx, string(ScheduledSet), start, count, false)
 }
 
 // ScanScheduledJobs scans scheduled jobs using a match pattern (no paging).
 func (c *Client) ScanScheduledJobs(ctx context.Context, match string) ([]*SortedEntry, error) {
-	return c.scanSortedSetJobs(ctx, "schedule", match, false)
+	return c.scanSortedSetJobs(ctx, string(ScheduledSet), match, false)
+}
+
+// FindJob looks up a job by JID in the retry, scheduled, and dead sets and
+// returns the set holding it. It returns ErrJobNotFound when no set does.
+func (c *Client) FindJob(ctx context.Context, jid string) (SortedSet, *SortedEntry, error) {
+	for _, set := range []SortedSet{RetrySet, ScheduledSet, DeadSet} {
+		entries, err := c.scanSortedSetJobs(ctx, string(set), jid, false)
+		if err != nil {
+			return "", nil, fmt.Errorf("scan %s: %w", set, err)
+		}
+		for _, entry := range entries {
+			if entry.JID() == jid {
+				return set, entry, nil
+			}
+		}
+	}
+	return "", nil, ErrJobNotFound
 }
@@ -3,15 +3,28 @@ package ui
 
 import (
 	"context"
+	"fmt"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
 	"time"
 
 	"charm.land/bubbles/v2/key"
 	tea "charm.land/bubbletea/v2"
 	"charm.land/lipgloss/v2"
+	"github.com/kpumuk/lazykiq/internal/config"
+	"github.com/kpumuk/lazykiq/internal/history"
 	"github.com/kpumuk/lazykiq/internal/sidekiq"
+	"github.com/kpumuk/lazykiq/internal/ui/clipboard"
+	"github.com/kpumuk/lazykiq/internal/ui/components/cmdline"
 	"github.com/kpumuk/lazykiq/internal/ui/components/errorpopup"
+	"github.com/kpumuk/lazykiq/internal/ui/components/helppopup"
+	"github.com/kpumuk/lazykiq/internal/ui/components/jobdetail"
 	"github.com/kpumuk/lazykiq/internal/ui/components/metrics"
 	"github.com/kpumuk/lazykiq/internal/ui/components/navbar"
+	"github.com/kpumuk/lazykiq/internal/ui/editor"
 	"github.com/kpumuk/lazykiq/internal/ui/theme"
 	"github.com/kpumuk/lazykiq/internal/ui/views"
 )
@@ -19,6 +32,9 @@ import (
 // tickMsg is sent every 5 seconds to trigger a metrics update.
 type tickMsg time.Time
 
+// renderTickMsg is sent every second to re-render relative times.
+type renderTickMsg time.Time
+
 // connectionErrorMsg indicates a Redis connection error occurred.
 type connectionErrorMsg struct {
 	err error
@@ -28,6 +44,8 @@ const dashboardViewIndex = 0
 
 // App is the main application model.
 type App struct {
+	cfg             config.Config
+	keyMaps         keyMaps
 	keys            KeyMap
 	width           int
 	height          int
@@ -37,15 +55,83 @@ type App struct {
 	metrics         metrics.Model
 	navbar          navbar.Model
 	errorPopup      errorpopup.Model
+	cmdline         cmdline.Model
+	help            helppopup.Model
+	showHelp        bool
 	styles          theme.Styles
 	sidekiq         *sidekiq.Client
+	ownsClient      bool
 	connectionError error
+	context         config.Context
+	contextName     string
+	themeName       string
+	mouse           bool
+	split           views.SplitLayout
+	queueNames      []string
+	flash           string
 }
 
-// New creates a new App instance.
-func New(client *sidekiq.Client) App {
-	styles := theme.NewStyles()
+// New creates a new App instance. contextName selects the config context
+// used for deployment-specific settings such as backtrace path mappings.
+func New(client *sidekiq.Client, cfg config.Config, contextName string) (App, error) {
+	keys, err := loadKeyMaps(cfg.Keys)
+	if err != nil {
+		return App{}, err
+	}
+
+	viewList, err := newViews(client, cfg, keys, contextName)
+	if err != nil {
+		return App{}, err
+	}
+
+	// Build navbar view infos
+	navViews := make([]navbar.ViewInfo, len(viewList))
+	viewKeys := keys.app.viewKeys()
+	for i, v := range viewList {
+		navViews[i] = navbar.ViewInfo{Name: v.Name(), Key: viewKeys[i].Help().Key}
+	}
 
+	a := App{
+		cfg:        cfg,
+		keyMaps:    keys,
+		keys:       keys.app,
+		activeView: 0,
+		views:      viewList,
+		metrics:    metrics.New(),
+		navbar: navbar.New(
+			navbar.WithViews(navViews),
+			navbar.WithQuitKey(keys.app.Quit.Help().Key),
+		),
+		errorPopup:  errorpopup.New(),
+		cmdline:     cmdline.New(),
+		help:        helppopup.New(),
+		sidekiq:     client,
+		context:     cfg.Contexts[contextName],
+		contextName: contextName,
+		mouse:       cfg.Mouse,
+		split: views.SplitLayout{
+			Enabled:  cfg.Split.Enabled,
+			MinWidth: cfg.Split.MinWidth,
+		},
+	}
+	a.cmdline.KeyMap = keys.cmdline
+	a.help.KeyMap = keys.help
+	a.applySplitLayout()
+
+	themeName := cfg.Theme
+	if themeName == "" {
+		themeName = theme.DefaultName()
+	}
+	if err := a.setTheme(themeName); err != nil {
+		return App{}, err
+	}
+
+	return a, nil
+}
+
+// newViews creates the views backed by client and applies user-configured
+// columns, key bindings, and the realtime history of the context.
+func newViews(client *sidekiq.Client, cfg config.Config, keys keyMaps, contextName string) ([]views.View, error) {
 	viewList := []views.View{
 		views.NewDashboard(client),
 		views.NewBusy(client),
@@ -53,9 +139,116 @@ func New(client *sidekiq.Client) App {
 		views.NewRetries(client),
 		views.NewScheduled(client),
 		views.NewDead(client),
+		views.NewRedis(client),
+		views.NewMetrics(client),
+		views.NewBatches(client),
+	}
+
+	store := historyStore(client, cfg, contextName)
+	var columnViews []string
+	for _, v := range viewList {
+		if configurable, ok := v.(views.KeyMapConfigurable); ok {
+			configurable.SetKeyMaps(keys.views)
+		}
+		if configurable, ok := v.(views.HistoryConfigurable); ok && store != nil {
+			configurable.SetHistory(store)
+		}
+		if configurable, ok := v.(views.ClipboardConfigurable); ok {
+			configurable.SetClipboardMode(clipboard.Mode(cfg.Clipboard))
+		}
+		if configurable, ok := v.(views.ThresholdConfigurable); ok {
+			configurable.SetRuntimeThresholds(views.RuntimeThresholds{
+				Default:  cfg.Busy.Threshold,
+				PerClass: cfg.Busy.Thresholds,
+			})
+		}
+		configurable, ok := v.(views.ColumnConfigurable)
+		if !ok {
+			continue
+		}
+		name := strings.ToLower(v.Name())
+		columnViews = append(columnViews, name)
+		if err := configurable.SetColumns(cfg.View(name).Columns); err != nil {
+			return nil, fmt.Errorf("views.%s.columns: %w", name, err)
+		}
+	}
+	for _, name := range slices.Sorted(maps.Keys(cfg.Views)) {
+		if !slices.Contains(columnViews, name) {
+			return nil, fmt.Errorf("views.%s: unknown view (available: %s)", name, strings.Join(columnViews, ", "))
+		}
 	}
 
-	// Apply styles to views
+	return viewList, nil
+}
+
+// historyStore returns the realtime history store for the context, named
+// after the Redis address when no context is used. It returns nil when
+// history is disabled.
+func historyStore(client *sidekiq.Client, cfg config.Config, contextName string) *history.Store {
+	if !cfg.History.Enabled || client == nil {
+		return nil
+	}
+	dir := cfg.History.Dir
+	if dir == "" {
+		dir = history.DefaultDir()
+	}
+	if dir == "" {
+		return nil
+	}
+	name := contextName
+	if name == "" {
+		name = client.Addr()
+	}
+	retention := time.Duration(cfg.History.Hours) * time.Hour
+	return history.NewStore(filepath.Join(dir, history.FileName(name)), retention)
+}
+
+// applySplitLayout pushes the split layout settings to the job list views.
+func (a *App) applySplitLayout() {
+	for _, v := range a.views {
+		if splittable, ok := v.(views.SplitConfigurable); ok {
+			splittable.SetSplitLayout(a.split)
+		}
+	}
+}
+
+// Close releases the Redis client the app created when switching contexts.
+// The client passed to New is owned by the caller.
+func (a App) Close() error {
+	if !a.ownsClient {
+		return nil
+	}
+	return a.sidekiq.Close()
+}
+
+// setTheme loads the named theme and re-applies styles to every component.
+func (a *App) setTheme(name string) error {
+	t, err := theme.Lookup(name)
+	if err != nil {
+		return err
+	}
+	a.themeName = name
+	a.styles = theme.NewStyles(t)
+	a.applyStyles()
+	return nil
+}
+
+// nextTheme switches to the theme after the current one, skipping themes
+// that fail to load.
+func (a *App) nextTheme() {
+	names := theme.Names()
+	current := slices.Index(names, a.themeName)
+	for i := 1; i <= len(names); i++ {
+		if a.setTheme(names[(current+i)%len(names)]) == nil {
+			return
+		}
+	}
+}
+
+// applyStyles pushes the current styles to views and components.
+func (a *App) applyStyles() {
+	styles := a.styles
+
 	viewStyles := views.Styles{
 		Text:            styles.ViewText,
 		Muted:           styles.ViewMuted,
@@ -78,47 +271,45 @@ func New(client *sidekiq.Client) App {
 		JSONNull:        styles.JSONNull,
 		JSONPunctuation: styles.JSONPunctuation,
 	}
-	for i := range viewList {
-		viewList[i] = viewList[i].SetStyles(viewStyles)
-	}
-
-	// Build navbar view infos
-	navViews := make([]navbar.ViewInfo, len(viewList))
-	for i, v := range viewList {
-		navViews[i] = navbar.ViewInfo{Name: v.Name()}
+	for i := range a.views {
+		a.views[i] = a.views[i].SetStyles(viewStyles)
 	}
 
-	return App{
-		keys:       DefaultKeyMap(),
-		activeView: 0,
-		views:      viewList,
-		metrics: metrics.New(
-			metrics.WithStyles(metrics.Styles{
-				Bar:   styles.MetricsBar,
-				Fill:  styles.MetricsFill,
-				Label: styles.MetricsLabel,
-				Value: styles.MetricsValue,
-			}),
-		),
-		navbar: navbar.New(
-			navbar.WithStyles(navbar.Styles{
-				Bar:  styles.NavBar,
-				Key:  styles.NavKey,
-				Item: styles.NavItem,
-				Quit: styles.NavQuit,
-			}),
-			navbar.WithViews(navViews),
-		),
-		errorPopup: errorpopup.New(
-			errorpopup.WithStyles(errorpopup.Styles{
-				Title:   styles.ErrorTitle,
-				Message: styles.ViewMuted,
-				Border:  styles.ErrorBorder,
-			}),
-		),
-		styles:  styles,
-		sidekiq: client,
-	}
+	a.metrics.SetStyles(metrics.Styles{
+		Bar:   styles.MetricsBar,
+		Fill:  styles.MetricsFill,
+		Label: styles.MetricsLabel,
+		Value: styles.MetricsValue,
+	})
+	a.navbar.SetStyles(navbar.Styles{
+		Bar:  styles.NavBar,
+		Key:  styles.NavKey,
+		Item: styles.NavItem,
+		Quit: styles.NavQuit,
+	})
+	a.errorPopup.SetStyles(errorpopup.Styles{
+		Title:   styles.ErrorTitle,
+		Message: styles.ViewMuted,
+		Border:  styles.ErrorBorder,
+	})
+	a.help.SetStyles(helppopup.Styles{
+		Title:       styles.ViewTitle,
+		Border:      styles.FocusBorder,
+		Section:     styles.ViewTitle,
+		Key:         styles.NavKey,
+		Description: styles.ViewMuted,
+	})
+	a.cmdline.SetStyles(cmdline.Styles{
+		Title:       styles.ViewTitle,
+		Border:      styles.FocusBorder,
+		Prompt:      styles.NavKey,
+		Text:        styles.ViewText,
+		Placeholder: styles.ViewMuted,
+		Cursor:      styles.ViewText,
+		Suggestion:  styles.ViewText,
+		Selected:    styles.TableSelected,
+		Description: styles.ViewMuted,
+	})
 }
 
 // Init implements tea.Model.
@@ -126,8 +317,9 @@ func (a App) Init() tea.Cmd {
 	return tea.Batch(
 		a.views[a.activeView].Init(),
 		a.metrics.Init(),
-		func() tea.Msg { return a.fetchStatsCmd() }, // Fetch stats immediately
+		views.ClientCmd(a.sidekiq, a.fetchStatsCmd), // Fetch stats immediately
 		tickCmd(), // Start the ticker for subsequent updates
+		renderTickCmd(),
 	)
 }
 
@@ -138,6 +330,13 @@ func tickCmd() tea.Cmd {
 	})
 }
 
+// renderTickCmd returns a command that sends a render tick after a second.
+func renderTickCmd() tea.Cmd {
+	return tea.Tick(time.Second, func(t time.Time) tea.Msg {
+		return renderTickMsg(t)
+	})
+}
+
 // fetchStatsCmd fetches Sidekiq stats and returns a metrics.UpdateMsg or connectionErrorMsg.
 func (a App) fetchStatsCmd() tea.Msg {
 	ctx := context.Background()
@@ -156,10 +355,67 @@ func (a App) fetchStatsCmd() tea.Msg {
 			Retries:   stats.Retries,
 			Scheduled: stats.Scheduled,
 			Dead:      stats.Dead,
+
+			Processes:      stats.Processes,
+			StaleProcesses: stats.StaleProcesses,
 		},
 	}
 }
 
+// openFrameCmd opens a backtrace frame in the external editor, mapping the
+// deployment path to the local checkout configured for the current context.
+func (a App) openFrameCmd(msg jobdetail.OpenFrameMsg) tea.Cmd {
+	path := a.context.MapPath(msg.File)
+	if _, err := os.Stat(path); err != nil {
+		return func() tea.Msg {
+			return jobdetail.StatusMsg{Text: "File not found: " + path}
+		}
+	}
+
+	return tea.ExecProcess(editor.Command(path, msg.Line), func(err error) tea.Msg {
+		if err != nil {
+			return jobdetail.StatusMsg{Text: "Editor failed: " + err.Error()}
+		}
+		return nil
+	})
+}
+
+// handleMouse switches views on navbar clicks and forwards other mouse
+// events to the active view with coordinates relative to the content area.
+func (a *App) handleMouse(msg tea.MouseMsg) tea.Cmd {
+	m := msg.Mouse()
+	navbarTop := a.height - a.navbar.Height()
+	contentTop := a.metrics.Height()
+
+	if m.Y >= navbarTop {
+		if _, ok := msg.(tea.MouseClickMsg); !ok || m.Button != tea.MouseLeft {
+			return nil
+		}
+		if idx := a.navbar.ViewAt(m.X); idx >= 0 && idx != a.activeView {
+			return a.switchView(idx)
+		}
+		return nil
+	}
+	if m.Y < contentTop {
+		return nil
+	}
+
+	m.Y -= contentTop
+	var viewMsg tea.Msg
+	switch msg.(type) {
+	case tea.MouseClickMsg:
+		viewMsg = tea.MouseClickMsg(m)
+	case tea.MouseWheelMsg:
+		viewMsg = tea.MouseWheelMsg(m)
+	default:
+		return nil
+	}
+
+	updatedView, cmd := a.views[a.activeView].Update(viewMsg)
+	a.views[a.activeView] = updatedView
+	return cmd
+}
+
 // Update implements tea.Model.
 func (a App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	var cmds []tea.Cmd
@@ -167,9 +423,7 @@ func (a App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	switch msg := msg.(type) {
 	case tickMsg:
 		// Always fetch stats for metrics bar
-		cmds = append(cmds, func() tea.Msg {
-			return a.fetchStatsCmd()
-		})
+		cmds = append(cmds, views.ClientCmd(a.sidekiq, a.fetchStatsCmd))
 
 		// Broadcast refresh to active view (views now fetch their own data)
 		updatedView, cmd := a.views[a.activeView].Update(views.RefreshMsg{})
@@ -178,6 +432,19 @@ func (a App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 		cmds = append(cmds, tickCmd())
 
+	case renderTickMsg:
+		// Relative times move between refreshes without touching Redis
+		updatedView, cmd := a.views[a.activeView].Update(views.RenderTickMsg{})
+		a.views[a.activeView] = updatedView
+		cmds = append(cmds, cmd, renderTickCmd())
+
+	case views.ClientMsg:
+		// Drop results and ticks of the client of a previous context
+		if msg.Client != a.sidekiq {
+			return a, nil
+		}
+		return a.Update(msg.Msg)
+
 	case connectionErrorMsg:
 		// Store the connection error
 		a.connectionError = msg.err
@@ -186,6 +453,15 @@ func (a App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		// Handle connection errors from views
 		a.connectionError = msg.Err
 
+	case jobdetail.OpenFrameMsg:
+		cmds = append(cmds, a.openFrameCmd(msg))
+
+	case jobdetail.OpenBatchMsg:
+		cmds = append(cmds, a.showBatch(msg.BID))
+
+	case views.FindJobMsg:
+		cmds = append(cmds, a.findJobCmd(msg.JID))
+
 	case views.DashboardTickMsg:
 		updatedView, cmd := a.views[dashboardViewIndex].Update(msg)
 		a.views[dashboardViewIndex] = updatedView
@@ -201,7 +477,37 @@ func (a App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		a.views[dashboardViewIndex] = updatedView
 		cmds = append(cmds, cmd)
 
+	case cmdline.ActionMsg:
+		cmds = append(cmds, a.handleCommandLine(msg))
+
+	case queueNamesMsg:
+		a.queueNames = msg.names
+		if a.cmdline.Active() {
+			a.cmdline.SetSuggestions(a.commandSuggestions())
+		}
+
+	case jobFoundMsg:
+		cmds = append(cmds, a.showJob(msg))
+
+	case flashMsg:
+		a.flash = msg.text
+
 	case tea.KeyMsg:
+		a.flash = ""
+		if a.cmdline.Active() {
+			var cmd tea.Cmd
+			a.cmdline, cmd = a.cmdline.Update(msg)
+			return a, cmd
+		}
+		if a.showHelp {
+			if key.Matches(msg, a.keys.Help) || key.Matches(msg, a.help.KeyMap.Close) {
+				a.showHelp = false
+				return a, nil
+			}
+			a.help, _ = a.help.Update(msg)
+			return a, nil
+		}
+
 		if view, ok := a.views[a.activeView].(interface{ FilterFocused() bool }); ok && view.FilterFocused() {
 			updatedView, cmd := a.views[a.activeView].Update(msg)
 			a.views[a.activeView] = updatedView
@@ -213,34 +519,69 @@ func (a App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		case key.Matches(msg, a.keys.Quit):
 			return a, tea.Quit
 
+		case key.Matches(msg, a.keys.Help):
+			a.help.SetSections(a.helpSections())
+			a.showHelp = true
+
+		case key.Matches(msg, a.keys.Command):
+			cmds = append(cmds, a.openCommandLine())
+
+		case key.Matches(msg, a.keys.Theme):
+			a.nextTheme()
+
+		case key.Matches(msg, a.keys.Mouse):
+			a.mouse = !a.mouse
+
+		case key.Matches(msg, a.keys.Split):
+			a.split.Enabled = !a.split.Enabled
+			a.applySplitLayout()
+
 		case key.Matches(msg, a.keys.View1):
-			a.activeView = 0
-			cmds = append(cmds, a.views[a.activeView].Init())
+			cmds = append(cmds, a.switchView(0))
 
 		case key.Matches(msg, a.keys.View2):
-			a.activeView = 1
-			cmds = append(cmds, a.views[a.activeView].Init())
+			cmds = append(cmds, a.switchView(1))
 
 		case key.Matches(msg, a.keys.View3):
-			a.activeView = 2
-			cmds = append(cmds, a.views[a.activeView].Init())
+			cmds = append(cmds, a.switchView(2))
 
 		case key.Matches(msg, a.keys.View4):
-			a.activeView = 3
-			cmds = append(cmds, a.views[a.activeView].Init())
+			cmds = append(cmds, a.switchView(3))
 
 		case key.Matches(msg, a.keys.View5):
-			a.activeView = 4
-			cmds = append(cmds, a.views[a.activeView].Init())
+			cmds = append(cmds, a.switchView(4))
 
 		case key.Matches(msg, a.keys.View6):
-			a.activeView = 5
-			cmds = append(cmds, a.views[a.activeView].Init())
+			cmds = append(cmds, a.switchView(5))
+
+		case key.Matches(msg, a.keys.View7):
+			cmds = append(cmds, a.switchView(6))
+
+		case key.Matches(msg, a.keys.View8):
+			cmds = append(cmds, a.switchView(7))
+
+		case key.Matches(msg, a.keys.View9):
+			cmds = append(cmds, a.switchView(8))
 
 		default:
 			// Pass to active view
-			updatedView, cmd := a.views[a.activeView].Update(msg)
-			a.views[a.activeView] = updatedView
+			cmds = append(cmds, a.updateActiveView(msg))
+		}
+
+	case tea.MouseClickMsg:
+		if a.showHelp {
+			break
+		}
+		if cmd := a.handleMouse(msg); cmd != nil {
+			cmds = append(cmds, cmd)
+		}
+
+	case tea.MouseWheelMsg:
+		if a.showHelp {
+			a.help, _ = a.help.Update(msg)
+			break
+		}
+		if cmd := a.handleMouse(msg); cmd != nil {
 			cmds = append(cmds, cmd)
 		}
 
@@ -252,14 +593,8 @@ func (a App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		// Update component dimensions
 		a.metrics.SetWidth(msg.Width)
 		a.navbar.SetWidth(msg.Width)
-
-		// Calculate content size (total - metrics - navbar)
-		contentHeight := msg.Height - a.metrics.Height() - a.navbar.Height()
-		contentWidth := msg.Width
-		for i := range a.views {
-			a.views[i] = a.views[i].SetSize(contentWidth, contentHeight)
-		}
-		a.errorPopup.SetSize(contentWidth, contentHeight)
+		a.cmdline.SetWidth(msg.Width)
+		a.resizeViews()
 
 	default:
 		// Clear connection error on successful metrics update
@@ -272,19 +607,47 @@ func (a App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		a.metrics = updatedMetrics
 		cmds = append(cmds, cmd)
 
+		if a.cmdline.Active() {
+			a.cmdline, cmd = a.cmdline.Update(msg)
+			cmds = append(cmds, cmd)
+		}
+
 		// Pass to active view
-		updatedView, cmd := a.views[a.activeView].Update(msg)
-		a.views[a.activeView] = updatedView
-		cmds = append(cmds, cmd)
+		cmds = append(cmds, a.updateActiveView(msg))
 	}
 
 	return a, tea.Batch(cmds...)
 }
 
+// resizeViews sizes views and overlays to the content area between the
+// metrics bar and the navbar.
+func (a *App) resizeViews() {
+	contentHeight := a.height - a.metrics.Height() - a.navbar.Height()
+	for i := range a.views {
+		a.views[i] = a.views[i].SetSize(a.width, contentHeight)
+	}
+	a.errorPopup.SetSize(a.width, contentHeight)
+	a.help.SetSize(a.width, contentHeight)
+}
+
+// helpSections composes the help overlay from the active view's bindings
+// for its current mode followed by the global bindings.
+func (a App) helpSections() []helppopup.Section {
+	var sections []helppopup.Section
+	for _, section := range a.views[a.activeView].FullHelp() {
+		sections = append(sections, helppopup.Section{Title: section.Title, Bindings: section.Bindings})
+	}
+	global := slices.Concat(a.keys.FullHelp()...)
+	return append(sections, helppopup.Section{Title: "Global", Bindings: global})
+}
+
 // View implements tea.Model.
 func (a App) View() tea.View {
 	var v tea.View
 	v.AltScreen = true
+	if a.mouse {
+		v.MouseMode = tea.MouseModeCellMotion
+	}
 
 	if !a.ready {
 		v.SetContent("Initializing...")
@@ -292,39 +655,52 @@ func (a App) View() tea.View {
 	}
 
 	content := a.views[a.activeView].View()
+	contentHeight := a.height - a.metrics.Height() - a.navbar.Height()
+
+	bottom := a.navbar.View()
+	if a.flash != "" {
+		bottom = a.styles.NavBar.Width(a.width).MaxWidth(a.width).Render(a.styles.ErrorTitle.Render(a.flash))
+	}
+
+	// Build the layout: metrics (top) + content (middle) + navbar (bottom)
+	base := lipgloss.JoinVertical(
+		lipgloss.Left,
+		a.metrics.View(),
+		content,
+		bottom,
+	)
+	layers := []*lipgloss.Layer{lipgloss.NewLayer(base)}
 
 	// If there's a connection error, overlay the error popup
 	if a.connectionError != nil {
 		a.errorPopup.SetMessage(a.connectionError.Error())
-		errorPanel := a.errorPopup.View()
-		if errorPanel != "" {
+		if errorPanel := a.errorPopup.View(); errorPanel != "" {
 			panelWidth := lipgloss.Width(errorPanel)
 			panelHeight := lipgloss.Height(errorPanel)
-			contentHeight := a.height - a.metrics.Height() - a.navbar.Height()
 			panelX := max((a.width-panelWidth)/2, 0)
 			panelY := a.metrics.Height() + max((contentHeight-panelHeight)/2, 0)
+			layers = append(layers, lipgloss.NewLayer(errorPanel).X(panelX).Y(panelY).Z(1))
+		}
+	}
 
-			canvas := lipgloss.NewCanvas(
-				lipgloss.NewLayer(lipgloss.JoinVertical(
-					lipgloss.Left,
-					a.metrics.View(),
-					content,
-					a.navbar.View(),
-				)),
-				lipgloss.NewLayer(errorPanel).X(panelX).Y(panelY).Z(1),
-			)
-			v.SetContent(canvas.Render())
-			return v
+	if a.showHelp {
+		if helpPanel := a.help.View(); helpPanel != "" {
+			panelX := max((a.width-lipgloss.Width(helpPanel))/2, 0)
+			panelY := a.metrics.Height() + max((contentHeight-lipgloss.Height(helpPanel))/2, 0)
+			layers = append(layers, lipgloss.NewLayer(helpPanel).X(panelX).Y(panelY).Z(2))
 		}
 	}
 
-	// Build the layout: metrics (top) + content (middle) + navbar (bottom)
-	v.SetContent(lipgloss.JoinVertical(
-		lipgloss.Left,
-		a.metrics.View(),
-		content,
-		a.navbar.View(),
-	))
+	// The command line sits at the bottom of the content area
+	if commandLine := a.cmdline.View(); commandLine != "" {
+		panelY := a.metrics.Height() + max(contentHeight-lipgloss.Height(commandLine), 0)
+		layers = append(layers, lipgloss.NewLayer(commandLine).Y(panelY).Z(3))
+	}
 
+	if len(layers) == 1 {
+		v.SetContent(base)
+		return v
+	}
+	v.SetContent(lipgloss.NewCanvas(layers...).Render())
 	return v
 }
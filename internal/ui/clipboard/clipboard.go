@@ -0,0 +1,116 @@
+// Package clipboard copies text to the system clipboard using OSC 52,
+// falling back to a temp file when the terminal cannot receive it.
+package clipboard
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/charmbracelet/x/ansi"
+)
+
+// Mode selects how text is copied.
+type Mode string
+
+const (
+	// ModeAuto uses OSC 52 unless the terminal is known not to support it.
+	ModeAuto Mode = "auto"
+	// ModeOSC52 always uses OSC 52.
+	ModeOSC52 Mode = "osc52"
+	// ModeFile always writes a temp file.
+	ModeFile Mode = "file"
+)
+
+// maxOSC52Bytes caps the payload sent via OSC 52. Many terminals silently
+// drop larger sequences, so bigger payloads are written to a file instead.
+const maxOSC52Bytes = 74994
+
+// screenChunkSize is the maximum string sequence length accepted by GNU Screen.
+const screenChunkSize = 768
+
+// CopiedMsg reports the result of a copy.
+type CopiedMsg struct {
+	// Label describes what was copied (e.g. "JID").
+	Label string
+	// Path is set when the text was written to a file instead of the clipboard.
+	Path string
+	Err  error
+}
+
+// Status returns a short human-readable description of the result.
+func (m CopiedMsg) Status() string {
+	switch {
+	case m.Err != nil:
+		return fmt.Sprintf("Copy %s failed: %v", m.Label, m.Err)
+	case m.Path != "":
+		return fmt.Sprintf("%s saved to %s", m.Label, m.Path)
+	default:
+		return "Copied " + m.Label
+	}
+}
+
+// Copy returns a command that copies text in the given mode and reports a
+// CopiedMsg. An empty mode is ModeAuto.
+func Copy(mode Mode, label, text string) tea.Cmd {
+	if !useOSC52(mode, len(text)) {
+		return func() tea.Msg {
+			path, err := writeFile(label, text)
+			return CopiedMsg{Label: label, Path: path, Err: err}
+		}
+	}
+
+	return tea.Sequence(
+		tea.Raw(sequence(text, os.Getenv("TMUX"), os.Getenv("TERM"))),
+		func() tea.Msg {
+			return CopiedMsg{Label: label}
+		},
+	)
+}
+
+func useOSC52(mode Mode, size int) bool {
+	switch mode {
+	case ModeFile:
+		return false
+	case ModeOSC52:
+		return true
+	}
+	if size > maxOSC52Bytes {
+		return false
+	}
+	switch os.Getenv("TERM") {
+	case "", "dumb", "linux":
+		return false
+	}
+	return true
+}
+
+// sequence builds the OSC 52 sequence, wrapped for tmux or GNU Screen when needed.
+func sequence(text, tmux, term string) string {
+	seq := ansi.SetSystemClipboard(text)
+	switch {
+	case tmux != "":
+		return ansi.TmuxPassthrough(seq)
+	case strings.HasPrefix(term, "screen"):
+		return ansi.ScreenPassthrough(seq, screenChunkSize)
+	default:
+		return seq
+	}
+}
+
+func writeFile(label, text string) (string, error) {
+	name := strings.ToLower(strings.Join(strings.Fields(label), "-"))
+	file, err := os.CreateTemp("", "lazykiq-"+name+"-*.txt")
+	if err != nil {
+		return "", err
+	}
+	if _, err := file.WriteString(text); err != nil {
+		_ = file.Close()
+		return "", err
+	}
+	if err := file.Close(); err != nil {
+		return "", err
+	}
+	return file.Name(), nil
+}
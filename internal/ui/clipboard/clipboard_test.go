@@ -0,0 +1,75 @@
+package clipboard
+
+import (
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestSequence(t *testing.T) {
+	plain := "\x1b]52;c;aGVsbG8=\x07"
+
+	tests := []struct {
+		name string
+		tmux string
+		term string
+		want string
+	}{
+		{name: "plain", term: "xterm-256color", want: plain},
+		{name: "tmux", tmux: "/tmp/tmux-1000/default,1,0", term: "tmux-256color", want: "\x1bPtmux;\x1b\x1b]52;c;aGVsbG8=\x07\x1b\\"},
+		{name: "screen", term: "screen-256color", want: "\x1bP" + plain + "\x1b\\"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := sequence("hello", tt.tmux, tt.term); got != tt.want {
+				t.Fatalf("sequence() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUseOSC52(t *testing.T) {
+	tests := []struct {
+		name string
+		mode Mode
+		term string
+		size int
+		want bool
+	}{
+		{name: "auto", mode: ModeAuto, term: "xterm-256color", size: 10, want: true},
+		{name: "auto-dumb", mode: ModeAuto, term: "dumb", size: 10, want: false},
+		{name: "auto-console", mode: ModeAuto, term: "linux", size: 10, want: false},
+		{name: "auto-too-large", mode: ModeAuto, term: "xterm-256color", size: maxOSC52Bytes + 1, want: false},
+		{name: "osc52", mode: ModeOSC52, term: "dumb", size: 10, want: true},
+		{name: "file", mode: ModeFile, term: "xterm-256color", size: 10, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("TERM", tt.term)
+			if got := useOSC52(tt.mode, tt.size); got != tt.want {
+				t.Fatalf("useOSC52(%d) = %v, want %v", tt.size, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWriteFile(t *testing.T) {
+	t.Setenv("TMPDIR", t.TempDir())
+
+	path, err := writeFile("Full Payload", "{}")
+	if err != nil {
+		t.Fatalf("writeFile() error = %v", err)
+	}
+	if !strings.Contains(path, "lazykiq-full-payload-") {
+		t.Fatalf("path = %q, want lazykiq-full-payload- prefix", path)
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("read file: %v", err)
+	}
+	if string(data) != "{}" {
+		t.Fatalf("content = %q, want %q", data, "{}")
+	}
+}
@@ -0,0 +1,280 @@
+package ui
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/kpumuk/lazykiq/internal/sidekiq"
+	"github.com/kpumuk/lazykiq/internal/ui/components/cmdline"
+	"github.com/kpumuk/lazykiq/internal/ui/theme"
+	"github.com/kpumuk/lazykiq/internal/ui/views"
+)
+
+// Commands accepted by the command line besides view names.
+const (
+	commandQueue   = "queue"
+	commandJID     = "jid"
+	commandContext = "context"
+	commandTheme   = "theme"
+	commandMouse   = "mouse"
+	commandQuit    = "quit"
+)
+
+// queueNamesMsg carries queue names for command completion.
+type queueNamesMsg struct {
+	names []string
+}
+
+// jobFoundMsg reports the sorted set holding a job looked up by JID.
+type jobFoundMsg struct {
+	set sidekiq.SortedSet
+	jid string
+}
+
+// flashMsg shows a message in place of the navbar until the next key press.
+type flashMsg struct {
+	text string
+}
+
+// openCommandLine opens the command line and refreshes queue names for
+// completion.
+func (a *App) openCommandLine() tea.Cmd {
+	a.cmdline.SetSuggestions(a.commandSuggestions())
+	client := a.sidekiq
+	return tea.Batch(a.cmdline.Open(), views.ClientCmd(client, func() tea.Msg {
+		queues, err := client.GetQueues(context.Background())
+		if err != nil {
+			return nil
+		}
+		names := make([]string, len(queues))
+		for i, queue := range queues {
+			names[i] = queue.Name()
+		}
+		return queueNamesMsg{names: names}
+	}))
+}
+
+// commandSuggestions lists completion candidates: views, queues, contexts,
+// themes, and actions.
+func (a App) commandSuggestions() []cmdline.Suggestion {
+	var suggestions []cmdline.Suggestion
+	for _, v := range a.views {
+		suggestions = append(suggestions, cmdline.Suggestion{
+			Text:        strings.ToLower(v.Name()),
+			Description: "show " + v.Name(),
+		})
+	}
+
+	suggestions = append(suggestions, cmdline.Suggestion{Text: commandQueue, Description: "show a queue", NeedsArg: true})
+	for _, name := range a.queueNames {
+		suggestions = append(suggestions, cmdline.Suggestion{Text: commandQueue + " " + name, Description: "show queue"})
+	}
+
+	suggestions = append(suggestions, cmdline.Suggestion{Text: commandJID, Description: "find a retry, scheduled, or dead job (not enqueued or busy)", NeedsArg: true})
+
+	if len(a.cfg.Contexts) > 0 {
+		suggestions = append(suggestions, cmdline.Suggestion{Text: commandContext, Description: "switch context", NeedsArg: true})
+		for _, name := range slices.Sorted(maps.Keys(a.cfg.Contexts)) {
+			desc := "switch context"
+			if name == a.contextName {
+				desc = "current context"
+			}
+			suggestions = append(suggestions, cmdline.Suggestion{Text: commandContext + " " + name, Description: desc})
+		}
+	}
+
+	suggestions = append(suggestions, cmdline.Suggestion{Text: commandTheme, Description: "switch theme", NeedsArg: true})
+	for _, name := range theme.Names() {
+		desc := "switch theme"
+		if name == a.themeName {
+			desc = "current theme"
+		}
+		suggestions = append(suggestions, cmdline.Suggestion{Text: commandTheme + " " + name, Description: desc})
+	}
+
+	return append(suggestions,
+		cmdline.Suggestion{Text: commandMouse, Description: "toggle mouse support"},
+		cmdline.Suggestion{Text: commandQuit, Description: "quit lazykiq"},
+	)
+}
+
+// handleCommandLine runs the submitted command, falling back to the
+// highlighted suggestion when the input itself is not a valid command.
+func (a *App) handleCommandLine(msg cmdline.ActionMsg) tea.Cmd {
+	if msg.Action != cmdline.ActionRun || msg.Input == "" {
+		return nil
+	}
+
+	cmd, err := a.runCommand(msg.Input)
+	if err != nil && msg.Selected != "" && msg.Selected != msg.Input {
+		var fallbackErr error
+		if cmd, fallbackErr = a.runCommand(msg.Selected); fallbackErr == nil {
+			err = nil
+		}
+	}
+	if err != nil {
+		a.flash = err.Error()
+		return nil
+	}
+	return cmd
+}
+
+// runCommand executes a single command line.
+func (a *App) runCommand(input string) (tea.Cmd, error) {
+	name, arg, _ := strings.Cut(strings.TrimSpace(input), " ")
+	arg = strings.TrimSpace(arg)
+
+	if idx := a.viewIndex(name); idx >= 0 && arg == "" {
+		return a.switchView(idx), nil
+	}
+
+	switch name {
+	case commandQueue:
+		if arg == "" {
+			return nil, errors.New("usage: queue <name>")
+		}
+		if len(a.queueNames) > 0 && !slices.Contains(a.queueNames, arg) {
+			return nil, fmt.Errorf("unknown queue %q", arg)
+		}
+		a.activeView = a.viewIndex("queues")
+		return a.updateActiveView(views.SelectQueueMsg{Name: arg}), nil
+
+	case commandJID:
+		if arg == "" {
+			return nil, errors.New("usage: jid <jid>")
+		}
+		return a.findJobCmd(arg), nil
+
+	case commandContext:
+		if arg == "" {
+			return nil, errors.New("usage: context <name>")
+		}
+		return a.switchContext(arg)
+
+	case commandTheme:
+		if arg == "" {
+			return nil, errors.New("usage: theme <name>")
+		}
+		return nil, a.setTheme(arg)
+
+	case commandMouse:
+		a.mouse = !a.mouse
+		return nil, nil
+
+	case commandQuit, "q":
+		return tea.Quit, nil
+	}
+
+	return nil, fmt.Errorf("unknown command %q", input)
+}
+
+// viewIndex returns the index of the view with the given lowercase name.
+func (a App) viewIndex(name string) int {
+	return slices.IndexFunc(a.views, func(v views.View) bool {
+		return strings.ToLower(v.Name()) == name
+	})
+}
+
+// switchView activates the view at idx and reinitializes it.
+func (a *App) switchView(idx int) tea.Cmd {
+	a.activeView = idx
+	return a.views[a.activeView].Init()
+}
+
+// updateActiveView passes msg to the active view.
+func (a *App) updateActiveView(msg tea.Msg) tea.Cmd {
+	updatedView, cmd := a.views[a.activeView].Update(msg)
+	a.views[a.activeView] = updatedView
+	return cmd
+}
+
+// findJobCmd looks up a job by JID in the retry, scheduled, and dead sets.
+func (a App) findJobCmd(jid string) tea.Cmd {
+	client := a.sidekiq
+	return views.ClientCmd(client, func() tea.Msg {
+		set, _, err := client.FindJob(context.Background(), jid)
+		if errors.Is(err, sidekiq.ErrJobNotFound) {
+			return flashMsg{text: fmt.Sprintf("job %s not found in retries, scheduled, or dead jobs", jid)}
+		}
+		if err != nil {
+			return views.ConnectionErrorMsg{Err: err}
+		}
+		return jobFoundMsg{set: set, jid: jid}
+	})
+}
+
+// showJob switches to the view of the set holding the job and opens it.
+func (a *App) showJob(msg jobFoundMsg) tea.Cmd {
+	var name string
+	switch msg.set {
+	case sidekiq.RetrySet:
+		name = "retries"
+	case sidekiq.ScheduledSet:
+		name = "scheduled"
+	case sidekiq.DeadSet:
+		name = "dead"
+	}
+	idx := a.viewIndex(name)
+	if idx < 0 {
+		return nil
+	}
+	a.activeView = idx
+	return a.updateActiveView(views.ShowJobMsg{JID: msg.jid})
+}
+
+// showBatch switches to the Batches view and opens the batch.
+func (a *App) showBatch(bid string) tea.Cmd {
+	idx := a.viewIndex("batches")
+	if idx < 0 {
+		return nil
+	}
+	a.activeView = idx
+	return a.updateActiveView(views.ShowBatchMsg{BID: bid})
+}
+
+// switchContext connects to the Redis instance of the named context and
+// rebuilds the views against it.
+func (a *App) switchContext(name string) (tea.Cmd, error) {
+	ctx, ok := a.cfg.Context(name)
+	if !ok {
+		return nil, fmt.Errorf("unknown context %q", name)
+	}
+
+	client, err := sidekiq.NewClient(ctx.Redis)
+	if err != nil {
+		return nil, fmt.Errorf("connect to context %s: %w", name, err)
+	}
+	viewList, err := newViews(client, a.cfg, a.keyMaps, name)
+	if err != nil {
+		_ = client.Close()
+		return nil, err
+	}
+
+	previous, ownedPrevious := a.sidekiq, a.ownsClient
+	a.sidekiq = client
+	a.ownsClient = true
+	a.context = ctx
+	a.contextName = name
+	a.views = viewList
+	a.queueNames = nil
+	a.connectionError = nil
+	a.resizeViews()
+	a.applyStyles()
+	a.applySplitLayout()
+
+	cmds := []tea.Cmd{
+		a.views[a.activeView].Init(),
+		views.ClientCmd(a.sidekiq, a.fetchStatsCmd),
+	}
+	// Requests still in flight fail, and like results that already arrived,
+	// their messages are dropped as they carry the previous client
+	if ownedPrevious {
+		_ = previous.Close()
+	}
+	return tea.Batch(cmds...), nil
+}
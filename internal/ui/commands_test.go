@@ -0,0 +1,95 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/kpumuk/lazykiq/internal/config"
+	"github.com/kpumuk/lazykiq/internal/sidekiq"
+	"github.com/kpumuk/lazykiq/internal/ui/components/cmdline"
+	"github.com/kpumuk/lazykiq/internal/ui/views"
+)
+
+func newTestApp(t *testing.T) App {
+	t.Helper()
+	a, err := New(nil, config.Config{Theme: "default"}, "")
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	return a
+}
+
+func TestNew_RejectsUnknownView(t *testing.T) {
+	cfg := config.Config{Theme: "default", Views: map[string]config.View{"retires": {}}}
+	_, err := New(nil, cfg, "")
+	if err == nil || !strings.Contains(err.Error(), "views.retires: unknown view") {
+		t.Fatalf("New() error = %v, want unknown view", err)
+	}
+}
+
+func TestRunCommand_SwitchesViews(t *testing.T) {
+	a := newTestApp(t)
+
+	if _, err := a.runCommand("retries"); err != nil {
+		t.Fatalf("runCommand(retries) error = %v", err)
+	}
+	if got := a.views[a.activeView].Name(); got != "Retries" {
+		t.Fatalf("active view = %q, want Retries", got)
+	}
+}
+
+func TestRunCommand_Errors(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{input: "nope", want: `unknown command "nope"`},
+		{input: "queue", want: "usage: queue <name>"},
+		{input: "queue missing", want: `unknown queue "missing"`},
+		{input: "jid", want: "usage: jid <jid>"},
+		{input: "context prod", want: `unknown context "prod"`},
+		{input: "theme nope", want: `unknown theme "nope"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			a := newTestApp(t)
+			a.queueNames = []string{"default"}
+			_, err := a.runCommand(tt.input)
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Fatalf("runCommand(%q) error = %v, want %q", tt.input, err, tt.want)
+			}
+		})
+	}
+}
+
+func TestHandleCommandLine_FallsBackToSelection(t *testing.T) {
+	a := newTestApp(t)
+
+	a.handleCommandLine(cmdline.ActionMsg{Action: cmdline.ActionRun, Input: "sched", Selected: "scheduled"})
+	if got := a.views[a.activeView].Name(); got != "Scheduled" {
+		t.Fatalf("active view = %q, want Scheduled", got)
+	}
+	if a.flash != "" {
+		t.Fatalf("flash = %q, want empty", a.flash)
+	}
+
+	a.handleCommandLine(cmdline.ActionMsg{Action: cmdline.ActionRun, Input: "bogus"})
+	if a.flash != `unknown command "bogus"` {
+		t.Fatalf("flash = %q, want unknown command error", a.flash)
+	}
+}
+
+func TestUpdate_DropsMessagesOfPreviousClient(t *testing.T) {
+	a := newTestApp(t)
+
+	model, _ := a.Update(views.ClientMsg{Client: new(sidekiq.Client), Msg: flashMsg{text: "stale"}})
+	if got := model.(App).flash; got != "" {
+		t.Fatalf("flash = %q, want message of another client dropped", got)
+	}
+
+	model, _ = a.Update(views.ClientMsg{Client: a.sidekiq, Msg: flashMsg{text: "current"}})
+	if got := model.(App).flash; got != "current" {
+		t.Fatalf("flash = %q, want current", got)
+	}
+}
@@ -0,0 +1,375 @@
+// Package cmdline provides a `:` command line with fuzzy completion.
+package cmdline
+
+import (
+	"fmt"
+	"strings"
+
+	"charm.land/bubbles/v2/key"
+	"charm.land/bubbles/v2/textinput"
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+	"github.com/kpumuk/lazykiq/internal/ui/components/frame"
+)
+
+// Action describes command line intents.
+type Action int
+
+const (
+	// ActionNone indicates no action.
+	ActionNone Action = iota
+	// ActionRun indicates the input should be executed.
+	ActionRun
+	// ActionCancel indicates the command line was dismissed.
+	ActionCancel
+)
+
+// ActionMsg reports a command line action. Selected holds the highlighted
+// suggestion, which callers can fall back to when Input is not a command.
+type ActionMsg struct {
+	Action   Action
+	Input    string
+	Selected string
+}
+
+// Suggestion is a completion candidate.
+type Suggestion struct {
+	Text        string
+	Description string
+	// NeedsArg marks commands that take an argument: accepting them
+	// completes the input instead of running it.
+	NeedsArg bool
+}
+
+// KeyMap defines keybindings for the command line. Keys not bound here
+// edit the input.
+type KeyMap struct {
+	Run      key.Binding
+	Cancel   key.Binding
+	Prev     key.Binding
+	Next     key.Binding
+	Complete key.Binding
+}
+
+// DefaultKeyMap returns a default set of keybindings.
+func DefaultKeyMap() KeyMap {
+	return KeyMap{
+		Run: key.NewBinding(
+			key.WithKeys("enter"),
+			key.WithHelp("enter", "run command"),
+		),
+		Cancel: key.NewBinding(
+			key.WithKeys("esc", "ctrl+c"),
+			key.WithHelp("esc", "cancel"),
+		),
+		Prev: key.NewBinding(
+			key.WithKeys("up", "ctrl+p"),
+			key.WithHelp("↑", "previous suggestion"),
+		),
+		Next: key.NewBinding(
+			key.WithKeys("down", "ctrl+n"),
+			key.WithHelp("↓", "next suggestion"),
+		),
+		Complete: key.NewBinding(
+			key.WithKeys("tab"),
+			key.WithHelp("tab", "complete"),
+		),
+	}
+}
+
+// Styles holds the styles used by the command line.
+type Styles struct {
+	Title       lipgloss.Style
+	Border      lipgloss.Style
+	Prompt      lipgloss.Style
+	Text        lipgloss.Style
+	Placeholder lipgloss.Style
+	Cursor      lipgloss.Style
+	Suggestion  lipgloss.Style
+	Selected    lipgloss.Style
+	Description lipgloss.Style
+}
+
+// DefaultStyles returns default styles for the command line.
+func DefaultStyles() Styles {
+	return Styles{
+		Title:       lipgloss.NewStyle().Bold(true),
+		Selected:    lipgloss.NewStyle().Reverse(true),
+		Description: lipgloss.NewStyle().Faint(true),
+	}
+}
+
+// Model defines state for the command line component.
+type Model struct {
+	KeyMap KeyMap
+
+	styles         Styles
+	input          textinput.Model
+	suggestions    []Suggestion
+	matches        []Suggestion
+	cursor         int
+	navigated      bool
+	width          int
+	maxSuggestions int
+}
+
+// Option configures the command line.
+type Option func(*Model)
+
+// New creates a new command line model.
+func New(opts ...Option) Model {
+	m := Model{
+		KeyMap:         DefaultKeyMap(),
+		styles:         DefaultStyles(),
+		input:          textinput.New(),
+		maxSuggestions: 8,
+	}
+	m.input.Prompt = ":"
+	m.input.Placeholder = "type a command"
+	m.input.Blur()
+
+	for _, opt := range opts {
+		opt(&m)
+	}
+
+	m.applyStyles()
+	m.applyWidth()
+
+	return m
+}
+
+// WithStyles sets the styles.
+func WithStyles(s Styles) Option {
+	return func(m *Model) {
+		m.styles = s
+	}
+}
+
+// WithWidth sets the available width.
+func WithWidth(width int) Option {
+	return func(m *Model) {
+		m.width = width
+	}
+}
+
+// WithMaxSuggestions sets how many suggestions are shown at once.
+func WithMaxSuggestions(n int) Option {
+	return func(m *Model) {
+		m.maxSuggestions = max(n, 1)
+	}
+}
+
+// SetStyles updates styles.
+func (m *Model) SetStyles(s Styles) {
+	m.styles = s
+	m.applyStyles()
+}
+
+// SetWidth updates available width.
+func (m *Model) SetWidth(width int) {
+	m.width = width
+	m.applyWidth()
+}
+
+// SetSuggestions replaces the completion candidates.
+func (m *Model) SetSuggestions(suggestions []Suggestion) {
+	m.suggestions = suggestions
+	m.refilter()
+}
+
+// Active reports whether the command line is open.
+func (m Model) Active() bool {
+	return m.input.Focused()
+}
+
+// Open clears the input and focuses the command line.
+func (m *Model) Open() tea.Cmd {
+	m.input.SetValue("")
+	m.refilter()
+	return m.input.Focus()
+}
+
+// Close hides the command line.
+func (m *Model) Close() {
+	m.input.Blur()
+	m.input.SetValue("")
+}
+
+// Selected returns the highlighted suggestion, if any.
+func (m Model) Selected() (Suggestion, bool) {
+	if m.cursor < 0 || m.cursor >= len(m.matches) {
+		return Suggestion{}, false
+	}
+	return m.matches[m.cursor], true
+}
+
+// Update handles key messages while the command line is open.
+func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
+	if !m.input.Focused() {
+		return m, nil
+	}
+
+	keyMsg, ok := msg.(tea.KeyMsg)
+	if !ok {
+		var cmd tea.Cmd
+		m.input, cmd = m.input.Update(msg)
+		return m, cmd
+	}
+
+	switch {
+	case key.Matches(keyMsg, m.KeyMap.Cancel):
+		m.Close()
+		return m, actionCmd(ActionMsg{Action: ActionCancel})
+
+	case key.Matches(keyMsg, m.KeyMap.Prev):
+		if len(m.matches) > 0 {
+			m.cursor = (m.cursor - 1 + len(m.matches)) % len(m.matches)
+			m.navigated = true
+		}
+		return m, nil
+
+	case key.Matches(keyMsg, m.KeyMap.Next):
+		if len(m.matches) > 0 {
+			m.cursor = (m.cursor + 1) % len(m.matches)
+			m.navigated = true
+		}
+		return m, nil
+
+	case key.Matches(keyMsg, m.KeyMap.Complete):
+		if s, ok := m.Selected(); ok {
+			m.complete(s)
+		}
+		return m, nil
+
+	case key.Matches(keyMsg, m.KeyMap.Run):
+		s, ok := m.Selected()
+		if ok && s.NeedsArg && (m.navigated || !strings.Contains(strings.TrimSpace(m.input.Value()), " ")) {
+			m.complete(s)
+			return m, nil
+		}
+		action := ActionMsg{
+			Action: ActionRun,
+			Input:  strings.TrimSpace(m.input.Value()),
+		}
+		if ok {
+			action.Selected = s.Text
+			if m.navigated {
+				action.Input = s.Text
+			}
+		}
+		m.Close()
+		return m, actionCmd(action)
+	}
+
+	before := m.input.Value()
+	var cmd tea.Cmd
+	m.input, cmd = m.input.Update(msg)
+	if m.input.Value() != before {
+		m.refilter()
+	}
+	return m, cmd
+}
+
+// Height returns the rendered height for the current matches.
+func (m Model) Height() int {
+	if !m.input.Focused() {
+		return 0
+	}
+	return m.visibleCount() + 3 // suggestions + input line + borders
+}
+
+// View renders the suggestions above the input line in a frame.
+func (m Model) View() string {
+	if !m.input.Focused() || m.width < 4 {
+		return ""
+	}
+
+	innerWidth := m.width - 2
+	start, end := m.visibleRange()
+	lines := make([]string, 0, end-start+1)
+	for i := start; i < end; i++ {
+		lines = append(lines, m.renderSuggestion(m.matches[i], i == m.cursor, innerWidth))
+	}
+	lines = append(lines, lipgloss.NewStyle().Width(innerWidth).MaxWidth(innerWidth).Render(m.input.View()))
+
+	meta := ""
+	if len(m.matches) > end-start {
+		meta = fmt.Sprintf("%d/%d", m.cursor+1, len(m.matches))
+	}
+
+	state := frame.StyleState{Title: m.styles.Title, Border: m.styles.Border}
+	return frame.New(
+		frame.WithStyles(frame.Styles{Focused: state, Blurred: state}),
+		frame.WithTitle("Command"),
+		frame.WithMeta(meta),
+		frame.WithContent(strings.Join(lines, "\n")),
+		frame.WithSize(m.width, len(lines)+2),
+		frame.WithFocused(true),
+	).View()
+}
+
+func (m Model) renderSuggestion(s Suggestion, selected bool, width int) string {
+	gap := ""
+	if s.Description != "" {
+		gap = strings.Repeat(" ", max(width-lipgloss.Width(s.Text)-lipgloss.Width(s.Description)-2, 2))
+	}
+	if selected {
+		return m.styles.Selected.Width(width).MaxWidth(width).Render(" " + s.Text + gap + s.Description)
+	}
+	line := " " + m.styles.Suggestion.Render(s.Text) + gap + m.styles.Description.Render(s.Description)
+	return lipgloss.NewStyle().Width(width).MaxWidth(width).Render(line)
+}
+
+func (m Model) visibleCount() int {
+	return min(len(m.matches), m.maxSuggestions)
+}
+
+// visibleRange returns the window of matches that keeps the cursor visible.
+func (m Model) visibleRange() (int, int) {
+	count := m.visibleCount()
+	start := max(m.cursor-count+1, 0)
+	return start, start + count
+}
+
+func (m *Model) complete(s Suggestion) {
+	value := s.Text
+	if s.NeedsArg {
+		value += " "
+	}
+	m.input.SetValue(value)
+	m.input.CursorEnd()
+	m.refilter()
+}
+
+func (m *Model) refilter() {
+	m.matches = Filter(m.input.Value(), m.suggestions)
+	m.cursor = 0
+	m.navigated = false
+}
+
+func (m *Model) applyStyles() {
+	styles := m.input.Styles()
+	styles.Focused.Prompt = m.styles.Prompt
+	styles.Focused.Text = m.styles.Text
+	styles.Focused.Placeholder = m.styles.Placeholder
+	styles.Blurred.Prompt = m.styles.Prompt
+	styles.Blurred.Text = m.styles.Text
+	styles.Blurred.Placeholder = m.styles.Placeholder
+	if cursorColor := m.styles.Cursor.GetForeground(); cursorColor != nil {
+		styles.Cursor.Color = cursorColor
+	}
+	m.input.SetStyles(styles)
+}
+
+func (m *Model) applyWidth() {
+	if m.width < 4 {
+		return
+	}
+	m.input.SetWidth(max(m.width-2-lipgloss.Width(m.input.Prompt)-1, 1))
+}
+
+func actionCmd(msg ActionMsg) tea.Cmd {
+	return func() tea.Msg {
+		return msg
+	}
+}
@@ -0,0 +1,137 @@
+package cmdline
+
+import (
+	"slices"
+	"testing"
+
+	tea "charm.land/bubbletea/v2"
+)
+
+func TestScore(t *testing.T) {
+	tests := []struct {
+		pattern string
+		target  string
+		ok      bool
+	}{
+		{pattern: "", target: "retries", ok: true},
+		{pattern: "ret", target: "retries", ok: true},
+		{pattern: "RTS", target: "retries", ok: true},
+		{pattern: "q crit", target: "queue critical", ok: true},
+		{pattern: "sir", target: "retries", ok: false},
+		{pattern: "retriess", target: "retries", ok: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.pattern+"/"+tt.target, func(t *testing.T) {
+			if _, ok := Score(tt.pattern, tt.target); ok != tt.ok {
+				t.Fatalf("Score(%q, %q) ok = %v, want %v", tt.pattern, tt.target, ok, tt.ok)
+			}
+		})
+	}
+}
+
+func TestFilter(t *testing.T) {
+	suggestions := []Suggestion{
+		{Text: "dashboard"},
+		{Text: "dead"},
+		{Text: "queue default"},
+		{Text: "queue critical"},
+		{Text: "queue"},
+	}
+
+	tests := []struct {
+		pattern string
+		want    []string
+	}{
+		{pattern: "", want: []string{"dashboard", "dead", "queue default", "queue critical", "queue"}},
+		{pattern: "de", want: []string{"dead", "queue default"}},
+		{pattern: "queue", want: []string{"queue", "queue default", "queue critical"}},
+		{pattern: "crit", want: []string{"queue critical"}},
+		{pattern: "dead", want: []string{"dead"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.pattern, func(t *testing.T) {
+			var got []string
+			for _, s := range Filter(tt.pattern, suggestions) {
+				got = append(got, s.Text)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Fatalf("Filter(%q) = %v, want %v", tt.pattern, got, tt.want)
+			}
+		})
+	}
+}
+
+func typeText(m Model, text string) Model {
+	for _, r := range text {
+		m, _ = m.Update(tea.KeyPressMsg{Code: r, Text: string(r)})
+	}
+	return m
+}
+
+func runAction(t *testing.T, cmd tea.Cmd) ActionMsg {
+	t.Helper()
+	if cmd == nil {
+		t.Fatal("expected action command")
+	}
+	msg, ok := cmd().(ActionMsg)
+	if !ok {
+		t.Fatalf("expected ActionMsg, got %T", cmd())
+	}
+	return msg
+}
+
+func TestEnterCompletesCommandsWithArguments(t *testing.T) {
+	m := New(WithWidth(40))
+	m.SetSuggestions([]Suggestion{
+		{Text: "queue", NeedsArg: true},
+		{Text: "retries"},
+	})
+	m.Open()
+	m = typeText(m, "qu")
+
+	m, cmd := m.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+	if cmd != nil {
+		t.Fatal("expected enter to complete instead of running")
+	}
+	if got := m.input.Value(); got != "queue " {
+		t.Fatalf("input = %q, want %q", got, "queue ")
+	}
+
+	m = typeText(m, "critical")
+	m, cmd = m.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+	action := runAction(t, cmd)
+	if action.Action != ActionRun || action.Input != "queue critical" {
+		t.Fatalf("action = %+v, want run %q", action, "queue critical")
+	}
+	if m.Active() {
+		t.Fatal("expected command line to close after running")
+	}
+}
+
+func TestEnterRunsNavigatedSuggestion(t *testing.T) {
+	m := New(WithWidth(40))
+	m.SetSuggestions([]Suggestion{{Text: "retries"}, {Text: "scheduled"}})
+	m.Open()
+
+	m, _ = m.Update(tea.KeyPressMsg{Code: tea.KeyDown})
+	_, cmd := m.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+	if action := runAction(t, cmd); action.Input != "scheduled" {
+		t.Fatalf("input = %q, want %q", action.Input, "scheduled")
+	}
+}
+
+func TestEscCancels(t *testing.T) {
+	m := New(WithWidth(40))
+	m.Open()
+	m = typeText(m, "ret")
+
+	m, cmd := m.Update(tea.KeyPressMsg{Code: tea.KeyEscape})
+	if action := runAction(t, cmd); action.Action != ActionCancel {
+		t.Fatalf("action = %v, want ActionCancel", action.Action)
+	}
+	if m.Active() {
+		t.Fatal("expected command line to close")
+	}
+}
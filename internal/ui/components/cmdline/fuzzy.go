@@ -0,0 +1,95 @@
+package cmdline
+
+import (
+	"cmp"
+	"slices"
+	"strings"
+	"unicode/utf8"
+)
+
+// Fuzzy match scoring weights.
+const (
+	scoreMatch       = 1
+	scoreConsecutive = 4
+	scoreWordStart   = 3
+	scorePrefix      = 20
+	scoreExact       = 100
+)
+
+// Score reports whether pattern is a case-insensitive subsequence of target
+// and how well it matches. Consecutive runs, word starts, prefixes, and exact
+// matches score higher.
+func Score(pattern, target string) (int, bool) {
+	p := []rune(strings.ToLower(pattern))
+	t := []rune(strings.ToLower(target))
+	if len(p) == 0 {
+		return 0, true
+	}
+
+	score := 0
+	pi := 0
+	last := -2
+	for ti, r := range t {
+		if pi == len(p) {
+			break
+		}
+		if r != p[pi] {
+			continue
+		}
+		score += scoreMatch
+		if ti == last+1 {
+			score += scoreConsecutive
+		}
+		if ti == 0 || isWordSeparator(t[ti-1]) {
+			score += scoreWordStart
+		}
+		last = ti
+		pi++
+	}
+	if pi < len(p) {
+		return 0, false
+	}
+
+	if strings.HasPrefix(string(t), string(p)) {
+		score += scorePrefix
+	}
+	if len(t) == len(p) {
+		score += scoreExact
+	}
+	return score, true
+}
+
+// Filter returns the suggestions matching pattern, best match first. Ties
+// keep shorter suggestions first, then the original order.
+func Filter(pattern string, suggestions []Suggestion) []Suggestion {
+	type scored struct {
+		suggestion Suggestion
+		score      int
+	}
+
+	pattern = strings.TrimSpace(pattern)
+	matches := make([]scored, 0, len(suggestions))
+	for _, s := range suggestions {
+		if score, ok := Score(pattern, s.Text); ok {
+			matches = append(matches, scored{suggestion: s, score: score})
+		}
+	}
+	if pattern != "" {
+		slices.SortStableFunc(matches, func(a, b scored) int {
+			if c := cmp.Compare(b.score, a.score); c != 0 {
+				return c
+			}
+			return cmp.Compare(utf8.RuneCountInString(a.suggestion.Text), utf8.RuneCountInString(b.suggestion.Text))
+		})
+	}
+
+	result := make([]Suggestion, len(matches))
+	for i, m := range matches {
+		result[i] = m.suggestion
+	}
+	return result
+}
+
+func isWordSeparator(r rune) bool {
+	return r == ' ' || r == '_' || r == '-' || r == '.' || r == ':'
+}
@@ -0,0 +1,325 @@
+// Package daterange provides a keyboard-driven start/end date picker.
+package daterange
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"charm.land/bubbles/v2/key"
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+)
+
+// DefaultMaxDays is the default longest range that can be picked.
+const DefaultMaxDays = 366
+
+const dateLayout = "2006-01-02"
+
+// Action describes date range picker intents.
+type Action int
+
+const (
+	// ActionNone indicates no action.
+	ActionNone Action = iota
+	// ActionApply indicates the picked range should be used.
+	ActionApply
+	// ActionCancel indicates the picker was dismissed.
+	ActionCancel
+)
+
+// ActionMsg reports a picker action with the picked UTC dates, inclusive.
+type ActionMsg struct {
+	Action Action
+	Start  time.Time
+	End    time.Time
+}
+
+const (
+	fieldStart = iota
+	fieldEnd
+)
+
+// KeyMap defines keybindings for the picker.
+type KeyMap struct {
+	Apply       key.Binding
+	Cancel      key.Binding
+	SwitchField key.Binding
+	NextDay     key.Binding
+	PrevDay     key.Binding
+	NextMonth   key.Binding
+	PrevMonth   key.Binding
+	Earliest    key.Binding
+	Today       key.Binding
+}
+
+// DefaultKeyMap returns a default set of keybindings.
+func DefaultKeyMap() KeyMap {
+	return KeyMap{
+		Apply: key.NewBinding(
+			key.WithKeys("enter"),
+			key.WithHelp("enter", "apply"),
+		),
+		Cancel: key.NewBinding(
+			key.WithKeys("esc", "ctrl+c"),
+			key.WithHelp("esc", "cancel"),
+		),
+		SwitchField: key.NewBinding(
+			key.WithKeys("tab", "shift+tab", "left", "right", "h", "l"),
+			key.WithHelp("tab", "switch"),
+		),
+		NextDay: key.NewBinding(
+			key.WithKeys("up", "k", "+"),
+			key.WithHelp("↑", "next day"),
+		),
+		PrevDay: key.NewBinding(
+			key.WithKeys("down", "j", "-"),
+			key.WithHelp("↓", "previous day"),
+		),
+		NextMonth: key.NewBinding(
+			key.WithKeys("pgup", "K"),
+			key.WithHelp("pgup", "next month"),
+		),
+		PrevMonth: key.NewBinding(
+			key.WithKeys("pgdown", "J"),
+			key.WithHelp("pgdn", "previous month"),
+		),
+		Earliest: key.NewBinding(
+			key.WithKeys("home"),
+			key.WithHelp("home", "earliest date"),
+		),
+		Today: key.NewBinding(
+			key.WithKeys("end"),
+			key.WithHelp("end", "today"),
+		),
+	}
+}
+
+// Styles holds the styles used by the picker.
+type Styles struct {
+	Label    lipgloss.Style
+	Value    lipgloss.Style
+	Selected lipgloss.Style
+	Muted    lipgloss.Style
+}
+
+// DefaultStyles returns default styles for the picker.
+func DefaultStyles() Styles {
+	return Styles{
+		Label:    lipgloss.NewStyle().Faint(true),
+		Selected: lipgloss.NewStyle().Reverse(true),
+		Muted:    lipgloss.NewStyle().Faint(true),
+	}
+}
+
+// Model defines state for the date range picker.
+type Model struct {
+	KeyMap KeyMap
+
+	styles  Styles
+	start   time.Time
+	end     time.Time
+	field   int
+	active  bool
+	maxDays int
+	now     func() time.Time
+}
+
+// Option configures the picker.
+type Option func(*Model)
+
+// New creates a new date range picker.
+func New(opts ...Option) Model {
+	m := Model{
+		KeyMap:  DefaultKeyMap(),
+		styles:  DefaultStyles(),
+		maxDays: DefaultMaxDays,
+		now:     time.Now,
+	}
+
+	for _, opt := range opts {
+		opt(&m)
+	}
+
+	return m
+}
+
+// WithStyles sets the styles.
+func WithStyles(s Styles) Option {
+	return func(m *Model) {
+		m.styles = s
+	}
+}
+
+// WithMaxDays sets the longest range that can be picked, counted back
+// from today.
+func WithMaxDays(days int) Option {
+	return func(m *Model) {
+		m.maxDays = max(days, 1)
+	}
+}
+
+// WithNow sets the clock used to determine today.
+func WithNow(now func() time.Time) Option {
+	return func(m *Model) {
+		m.now = now
+	}
+}
+
+// SetStyles updates styles.
+func (m *Model) SetStyles(s Styles) {
+	m.styles = s
+}
+
+// Active reports whether the picker is open.
+func (m Model) Active() bool {
+	return m.active
+}
+
+// Open shows the picker with the given range selected, editing the start date.
+func (m *Model) Open(start, end time.Time) {
+	m.active = true
+	m.field = fieldStart
+	m.start = m.clamp(day(start))
+	m.end = m.clamp(day(end))
+	if m.end.Before(m.start) {
+		m.start, m.end = m.end, m.start
+	}
+}
+
+// Close hides the picker.
+func (m *Model) Close() {
+	m.active = false
+}
+
+// Range returns the selected UTC dates.
+func (m Model) Range() (time.Time, time.Time) {
+	return m.start, m.end
+}
+
+// Update handles key messages while the picker is open.
+func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
+	keyMsg, ok := msg.(tea.KeyMsg)
+	if !m.active || !ok {
+		return m, nil
+	}
+
+	switch {
+	case key.Matches(keyMsg, m.KeyMap.Cancel):
+		m.Close()
+		return m, actionCmd(ActionMsg{Action: ActionCancel})
+	case key.Matches(keyMsg, m.KeyMap.Apply):
+		m.Close()
+		return m, actionCmd(ActionMsg{Action: ActionApply, Start: m.start, End: m.end})
+	case key.Matches(keyMsg, m.KeyMap.SwitchField):
+		m.field = 1 - m.field
+	case key.Matches(keyMsg, m.KeyMap.NextDay):
+		m.shift(0, 1)
+	case key.Matches(keyMsg, m.KeyMap.PrevDay):
+		m.shift(0, -1)
+	case key.Matches(keyMsg, m.KeyMap.NextMonth):
+		m.shift(1, 0)
+	case key.Matches(keyMsg, m.KeyMap.PrevMonth):
+		m.shift(-1, 0)
+	case key.Matches(keyMsg, m.KeyMap.Earliest):
+		m.setField(m.earliest())
+	case key.Matches(keyMsg, m.KeyMap.Today):
+		m.setField(m.today())
+	}
+	return m, nil
+}
+
+// View renders the picked dates and key hints.
+func (m Model) View() string {
+	if !m.active {
+		return ""
+	}
+
+	days := int(m.end.Sub(m.start).Hours()/24) + 1
+	dates := m.styles.Label.Render("From ") + m.renderDate(m.start, m.field == fieldStart) +
+		m.styles.Label.Render("  To ") + m.renderDate(m.end, m.field == fieldEnd) +
+		m.styles.Muted.Render(fmt.Sprintf("  %d %s", days, plural(days, "day", "days")))
+	k := m.KeyMap
+	hints := m.styles.Muted.Render(strings.Join([]string{
+		hint(k.NextDay.Help().Key+"/"+k.PrevDay.Help().Key, "day"),
+		hint(k.NextMonth.Help().Key+"/"+k.PrevMonth.Help().Key, "month"),
+		hint(k.SwitchField.Help().Key, k.SwitchField.Help().Desc),
+		hint(k.Apply.Help().Key, k.Apply.Help().Desc),
+		hint(k.Cancel.Help().Key, k.Cancel.Help().Desc),
+	}, " • "))
+	return lipgloss.JoinVertical(lipgloss.Center, dates, "", hints)
+}
+
+func hint(keys, desc string) string {
+	return keys + " " + desc
+}
+
+func (m Model) renderDate(date time.Time, selected bool) string {
+	text := " " + date.Format(dateLayout) + " "
+	if selected {
+		return m.styles.Selected.Render(text)
+	}
+	return m.styles.Value.Render(text)
+}
+
+// shift moves the edited date by months and days, keeping the range
+// within the allowed window and the start no later than the end.
+func (m *Model) shift(months, days int) {
+	date := m.start
+	if m.field == fieldEnd {
+		date = m.end
+	}
+	m.setField(date.AddDate(0, months, days))
+}
+
+func (m *Model) setField(date time.Time) {
+	date = m.clamp(date)
+	if m.field == fieldStart {
+		m.start = date
+		if m.end.Before(date) {
+			m.end = date
+		}
+		return
+	}
+	m.end = date
+	if m.start.After(date) {
+		m.start = date
+	}
+}
+
+func (m Model) clamp(date time.Time) time.Time {
+	if earliest := m.earliest(); date.Before(earliest) {
+		return earliest
+	}
+	if today := m.today(); date.After(today) {
+		return today
+	}
+	return date
+}
+
+func (m Model) today() time.Time {
+	return day(m.now())
+}
+
+func (m Model) earliest() time.Time {
+	return m.today().AddDate(0, 0, -(m.maxDays - 1))
+}
+
+// day returns midnight UTC of the date t falls on in UTC.
+func day(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+func plural(n int, singular, pluralForm string) string {
+	if n == 1 {
+		return singular
+	}
+	return pluralForm
+}
+
+func actionCmd(msg ActionMsg) tea.Cmd {
+	return func() tea.Msg {
+		return msg
+	}
+}
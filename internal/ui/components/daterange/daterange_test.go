@@ -0,0 +1,90 @@
+package daterange
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+)
+
+var testNow = time.Date(2026, 3, 15, 18, 30, 0, 0, time.UTC)
+
+func date(month time.Month, d int) time.Time {
+	return time.Date(2026, month, d, 0, 0, 0, 0, time.UTC)
+}
+
+func press(m Model, keys ...tea.KeyPressMsg) (Model, tea.Cmd) {
+	var cmd tea.Cmd
+	for _, k := range keys {
+		m, cmd = m.Update(k)
+	}
+	return m, cmd
+}
+
+func TestOpenClampsAndOrders(t *testing.T) {
+	m := New(WithNow(func() time.Time { return testNow }), WithMaxDays(30))
+	m.Open(testNow.AddDate(0, 0, 10), testNow.AddDate(-1, 0, 0))
+
+	start, end := m.Range()
+	if !start.Equal(date(time.February, 14)) || !end.Equal(date(time.March, 15)) {
+		t.Fatalf("Range() = %v..%v, want 2026-02-14..2026-03-15", start, end)
+	}
+}
+
+func TestShiftKeepsStartBeforeEnd(t *testing.T) {
+	m := New(WithNow(func() time.Time { return testNow }))
+	m.Open(date(time.March, 1), date(time.March, 3))
+
+	m, _ = press(m, tea.KeyPressMsg{Code: tea.KeyUp}, tea.KeyPressMsg{Code: tea.KeyUp}, tea.KeyPressMsg{Code: tea.KeyUp})
+	start, end := m.Range()
+	if !start.Equal(date(time.March, 4)) || !end.Equal(date(time.March, 4)) {
+		t.Fatalf("Range() = %v..%v, want end pushed to 2026-03-04", start, end)
+	}
+
+	m, _ = press(m, tea.KeyPressMsg{Code: tea.KeyTab}, tea.KeyPressMsg{Code: tea.KeyPgDown})
+	start, end = m.Range()
+	if !start.Equal(date(time.February, 4)) || !end.Equal(date(time.February, 4)) {
+		t.Fatalf("Range() = %v..%v, want start pulled to 2026-02-04", start, end)
+	}
+
+	m, _ = press(m, tea.KeyPressMsg{Code: tea.KeyEnd})
+	if _, end = m.Range(); !end.Equal(date(time.March, 15)) {
+		t.Fatalf("end = %v, want today", end)
+	}
+}
+
+func TestEnterAppliesAndEscCancels(t *testing.T) {
+	m := New(WithNow(func() time.Time { return testNow }))
+	m.Open(date(time.March, 1), date(time.March, 10))
+
+	m, cmd := press(m, tea.KeyPressMsg{Code: tea.KeyEnter})
+	msg, ok := cmd().(ActionMsg)
+	if !ok || msg.Action != ActionApply || !msg.Start.Equal(date(time.March, 1)) || !msg.End.Equal(date(time.March, 10)) {
+		t.Fatalf("enter = %+v, want apply 2026-03-01..2026-03-10", msg)
+	}
+	if m.Active() {
+		t.Fatal("expected picker to close after apply")
+	}
+
+	m.Open(date(time.March, 1), date(time.March, 10))
+	_, cmd = press(m, tea.KeyPressMsg{Code: tea.KeyEscape})
+	if msg, ok := cmd().(ActionMsg); !ok || msg.Action != ActionCancel {
+		t.Fatalf("esc = %+v, want cancel", msg)
+	}
+}
+
+func TestRemappedKeys(t *testing.T) {
+	m := New(WithNow(func() time.Time { return testNow }))
+	m.KeyMap.NextDay.SetKeys("n")
+	m.KeyMap.NextDay.SetHelp("n", "next day")
+	m.Open(date(time.March, 1), date(time.March, 3))
+
+	m, _ = press(m, tea.KeyPressMsg{Code: 'n', Text: "n"}, tea.KeyPressMsg{Code: tea.KeyUp})
+	if start, _ := m.Range(); !start.Equal(date(time.March, 2)) {
+		t.Fatalf("start = %v, want 2026-03-02 after one remapped press", start)
+	}
+	if view := m.View(); !strings.Contains(view, "n/↓ day") {
+		t.Fatalf("View() = %q, want remapped key hint", view)
+	}
+}
@@ -4,6 +4,7 @@ package filterinput
 import (
 	"strings"
 
+	"charm.land/bubbles/v2/key"
 	"charm.land/bubbles/v2/textinput"
 	tea "charm.land/bubbletea/v2"
 	"charm.land/lipgloss/v2"
@@ -27,6 +28,37 @@ type ActionMsg struct {
 	Query  string
 }
 
+// KeyMap defines keybindings for the filter input. Apply and Cancel are
+// matched while the input is focused, Focus and Clear otherwise.
+type KeyMap struct {
+	Focus  key.Binding
+	Clear  key.Binding
+	Apply  key.Binding
+	Cancel key.Binding
+}
+
+// DefaultKeyMap returns a default set of keybindings.
+func DefaultKeyMap() KeyMap {
+	return KeyMap{
+		Focus: key.NewBinding(
+			key.WithKeys("/"),
+			key.WithHelp("/", "filter"),
+		),
+		Clear: key.NewBinding(
+			key.WithKeys("esc", "ctrl+u"),
+			key.WithHelp("esc/ctrl+u", "clear filter"),
+		),
+		Apply: key.NewBinding(
+			key.WithKeys("enter"),
+			key.WithHelp("enter", "apply filter"),
+		),
+		Cancel: key.NewBinding(
+			key.WithKeys("esc"),
+			key.WithHelp("esc", "clear filter"),
+		),
+	}
+}
+
 // Styles holds the styles used by the filter input.
 type Styles struct {
 	Prompt      lipgloss.Style
@@ -42,6 +74,8 @@ func DefaultStyles() Styles {
 
 // Model defines state for the filter input component.
 type Model struct {
+	KeyMap KeyMap
+
 	styles            Styles
 	input             textinput.Model
 	width             int
@@ -57,6 +91,7 @@ type Option func(*Model)
 // New creates a new filter input model.
 func New(opts ...Option) Model {
 	m := Model{
+		KeyMap:            DefaultKeyMap(),
 		styles:            DefaultStyles(),
 		input:             textinput.New(),
 		prompt:            "FILTER: ",
@@ -132,6 +167,12 @@ func (m *Model) SetWidth(width int) {
 	m.applyWidth()
 }
 
+// SetQuery replaces the current query without emitting an action.
+func (m *Model) SetQuery(query string) {
+	m.query = query
+	m.input.SetValue(query)
+}
+
 // Query returns the current query.
 func (m Model) Query() string {
 	return m.query
@@ -142,6 +183,18 @@ func (m Model) Focused() bool {
 	return m.input.Focused()
 }
 
+// HelpBindings returns the filter keys available in the current state.
+func (m Model) HelpBindings() []key.Binding {
+	if m.input.Focused() {
+		return []key.Binding{m.KeyMap.Apply, m.KeyMap.Cancel}
+	}
+	bindings := []key.Binding{m.KeyMap.Focus}
+	if m.query != "" {
+		bindings = append(bindings, m.KeyMap.Clear)
+	}
+	return bindings
+}
+
 // Init resets focus and placeholder.
 func (m *Model) Init() {
 	m.input.SetValue(m.query)
@@ -154,8 +207,8 @@ func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
 	switch msg := msg.(type) {
 	case tea.KeyMsg:
 		if m.input.Focused() {
-			switch msg.String() {
-			case "enter":
+			switch {
+			case key.Matches(msg, m.KeyMap.Apply):
 				next := strings.TrimSpace(m.input.Value())
 				changed := next != m.query
 				m.query = next
@@ -166,7 +219,7 @@ func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
 					}
 				}
 				return m, nil
-			case "esc":
+			case key.Matches(msg, m.KeyMap.Cancel):
 				if m.query != "" {
 					m.query = ""
 					m.input.SetValue("")
@@ -184,11 +237,11 @@ func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
 			return m, cmd
 		}
 
-		switch msg.String() {
-		case "/":
+		switch {
+		case key.Matches(msg, m.KeyMap.Focus):
 			cmd := m.focus()
 			return m, cmd
-		case "esc", "ctrl+u":
+		case key.Matches(msg, m.KeyMap.Clear):
 			if m.query != "" {
 				m.query = ""
 				m.input.SetValue("")
@@ -0,0 +1,265 @@
+// Package helppopup renders a scrollable overlay listing key bindings.
+package helppopup
+
+import (
+	"fmt"
+	"strings"
+
+	"charm.land/bubbles/v2/key"
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+	"github.com/kpumuk/lazykiq/internal/ui/components/frame"
+)
+
+// mouseWheelLines is the number of lines scrolled per mouse wheel step.
+const mouseWheelLines = 3
+
+// Section groups key bindings under a title.
+type Section struct {
+	Title    string
+	Bindings []key.Binding
+}
+
+// KeyMap defines keybindings for the help popup. Close is matched by the
+// app showing the popup.
+type KeyMap struct {
+	LineUp     key.Binding
+	LineDown   key.Binding
+	PageUp     key.Binding
+	PageDown   key.Binding
+	GotoTop    key.Binding
+	GotoBottom key.Binding
+	Close      key.Binding
+}
+
+// DefaultKeyMap returns a default set of keybindings.
+func DefaultKeyMap() KeyMap {
+	return KeyMap{
+		LineUp: key.NewBinding(
+			key.WithKeys("up", "k"),
+			key.WithHelp("↑/k", "up"),
+		),
+		LineDown: key.NewBinding(
+			key.WithKeys("down", "j"),
+			key.WithHelp("↓/j", "down"),
+		),
+		PageUp: key.NewBinding(
+			key.WithKeys("pgup", "ctrl+u"),
+			key.WithHelp("pgup", "page up"),
+		),
+		PageDown: key.NewBinding(
+			key.WithKeys("pgdown", "ctrl+d", "space"),
+			key.WithHelp("pgdn", "page down"),
+		),
+		GotoTop: key.NewBinding(
+			key.WithKeys("home", "g"),
+			key.WithHelp("g/home", "go to start"),
+		),
+		GotoBottom: key.NewBinding(
+			key.WithKeys("end", "G"),
+			key.WithHelp("G/end", "go to end"),
+		),
+		Close: key.NewBinding(
+			key.WithKeys("esc", "q"),
+			key.WithHelp("esc", "close help"),
+		),
+	}
+}
+
+// Styles holds the styles needed by the help popup.
+type Styles struct {
+	Title       lipgloss.Style
+	Border      lipgloss.Style
+	Section     lipgloss.Style
+	Key         lipgloss.Style
+	Description lipgloss.Style
+}
+
+// DefaultStyles returns default styles for the help popup.
+func DefaultStyles() Styles {
+	return Styles{
+		Title:       lipgloss.NewStyle().Bold(true),
+		Section:     lipgloss.NewStyle().Bold(true),
+		Key:         lipgloss.NewStyle().Bold(true),
+		Description: lipgloss.NewStyle().Faint(true),
+	}
+}
+
+// Model defines state for the help popup component.
+type Model struct {
+	KeyMap KeyMap
+
+	styles   Styles
+	sections []Section
+	width    int
+	height   int
+	yOffset  int
+}
+
+// Option is used to set options in New.
+type Option func(*Model)
+
+// New creates a new help popup model.
+func New(opts ...Option) Model {
+	m := Model{
+		KeyMap: DefaultKeyMap(),
+		styles: DefaultStyles(),
+	}
+
+	for _, opt := range opts {
+		opt(&m)
+	}
+
+	return m
+}
+
+// WithStyles sets the styles.
+func WithStyles(s Styles) Option {
+	return func(m *Model) {
+		m.styles = s
+	}
+}
+
+// WithSize sets the available width and height.
+func WithSize(w, h int) Option {
+	return func(m *Model) {
+		m.width = w
+		m.height = h
+	}
+}
+
+// SetStyles sets the styles.
+func (m *Model) SetStyles(s Styles) {
+	m.styles = s
+}
+
+// SetSize sets the available width and height.
+func (m *Model) SetSize(w, h int) {
+	m.width = w
+	m.height = h
+	m.clampScroll()
+}
+
+// SetSections replaces the listed sections and scrolls back to the top.
+// Disabled bindings and empty sections are skipped.
+func (m *Model) SetSections(sections []Section) {
+	m.sections = nil
+	for _, section := range sections {
+		var bindings []key.Binding
+		for _, b := range section.Bindings {
+			if b.Enabled() && b.Help().Key != "" {
+				bindings = append(bindings, b)
+			}
+		}
+		if len(bindings) > 0 {
+			m.sections = append(m.sections, Section{Title: section.Title, Bindings: bindings})
+		}
+	}
+	m.yOffset = 0
+}
+
+// Update scrolls the popup on navigation keys and mouse wheel events.
+func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch {
+		case key.Matches(msg, m.KeyMap.LineUp):
+			m.scroll(-1)
+		case key.Matches(msg, m.KeyMap.LineDown):
+			m.scroll(1)
+		case key.Matches(msg, m.KeyMap.PageUp):
+			m.scroll(-m.visibleLines())
+		case key.Matches(msg, m.KeyMap.PageDown):
+			m.scroll(m.visibleLines())
+		case key.Matches(msg, m.KeyMap.GotoTop):
+			m.yOffset = 0
+		case key.Matches(msg, m.KeyMap.GotoBottom):
+			m.yOffset = m.maxYOffset()
+		}
+	case tea.MouseWheelMsg:
+		switch msg.Button {
+		case tea.MouseWheelUp:
+			m.scroll(-mouseWheelLines)
+		case tea.MouseWheelDown:
+			m.scroll(mouseWheelLines)
+		}
+	}
+	return m, nil
+}
+
+// View renders the popup sized to its content and the available space.
+func (m Model) View() string {
+	lines := m.lines()
+	if len(lines) == 0 || m.width < 6 || m.height < 3 {
+		return ""
+	}
+
+	contentWidth := 0
+	for _, line := range lines {
+		contentWidth = max(contentWidth, lipgloss.Width(line))
+	}
+	visible := m.visibleLines()
+	start := min(m.yOffset, m.maxYOffset())
+	end := min(start+visible, len(lines))
+
+	meta := ""
+	if len(lines) > visible {
+		meta = fmt.Sprintf("%d-%d/%d", start+1, end, len(lines))
+	}
+
+	// Leave room for borders and padding, and for the title and meta on the top border
+	panelWidth := min(max(contentWidth+4, lipgloss.Width(meta)+12), m.width)
+	panelHeight := min(len(lines)+2, m.height)
+
+	state := frame.StyleState{Title: m.styles.Title, Border: m.styles.Border}
+	return frame.New(
+		frame.WithStyles(frame.Styles{Focused: state, Blurred: state}),
+		frame.WithTitle("Help"),
+		frame.WithMeta(meta),
+		frame.WithContent(strings.Join(lines[start:end], "\n")),
+		frame.WithSize(panelWidth, panelHeight),
+		frame.WithPadding(1),
+		frame.WithFocused(true),
+	).View()
+}
+
+// lines renders sections as a title line followed by aligned bindings,
+// separated by blank lines.
+func (m Model) lines() []string {
+	keyWidth := 0
+	for _, section := range m.sections {
+		for _, b := range section.Bindings {
+			keyWidth = max(keyWidth, lipgloss.Width(b.Help().Key))
+		}
+	}
+
+	var lines []string
+	for i, section := range m.sections {
+		if i > 0 {
+			lines = append(lines, "")
+		}
+		lines = append(lines, m.styles.Section.Render(section.Title))
+		for _, b := range section.Bindings {
+			help := b.Help()
+			padding := strings.Repeat(" ", keyWidth-lipgloss.Width(help.Key))
+			lines = append(lines, "  "+m.styles.Key.Render(help.Key)+padding+"  "+m.styles.Description.Render(help.Desc))
+		}
+	}
+	return lines
+}
+
+func (m Model) visibleLines() int {
+	return max(m.height-2, 1)
+}
+
+func (m Model) maxYOffset() int {
+	return max(len(m.lines())-m.visibleLines(), 0)
+}
+
+func (m *Model) scroll(delta int) {
+	m.yOffset = max(min(m.yOffset+delta, m.maxYOffset()), 0)
+}
+
+func (m *Model) clampScroll() {
+	m.yOffset = max(min(m.yOffset, m.maxYOffset()), 0)
+}
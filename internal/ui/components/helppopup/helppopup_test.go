@@ -0,0 +1,57 @@
+package helppopup
+
+import (
+	"strings"
+	"testing"
+
+	"charm.land/bubbles/v2/key"
+	tea "charm.land/bubbletea/v2"
+	"github.com/charmbracelet/x/ansi"
+)
+
+func binding(k, desc string) key.Binding {
+	return key.NewBinding(key.WithKeys(k), key.WithHelp(k, desc))
+}
+
+func TestSetSectionsSkipsDisabledBindings(t *testing.T) {
+	disabled := binding("x", "disabled")
+	disabled.SetEnabled(false)
+
+	m := New(WithSize(40, 20))
+	m.SetSections([]Section{
+		{Title: "Table", Bindings: []key.Binding{binding("j", "down"), disabled}},
+		{Title: "Empty", Bindings: []key.Binding{disabled}},
+	})
+
+	view := ansi.Strip(m.View())
+	if !strings.Contains(view, "j  down") {
+		t.Fatalf("expected binding in view:\n%s", view)
+	}
+	if strings.Contains(view, "disabled") || strings.Contains(view, "Empty") {
+		t.Fatalf("expected disabled binding and empty section to be hidden:\n%s", view)
+	}
+}
+
+func TestScroll(t *testing.T) {
+	var bindings []key.Binding
+	for _, k := range strings.Split("abcdefghij", "") {
+		bindings = append(bindings, binding(k, "action "+k))
+	}
+
+	m := New(WithSize(40, 6))
+	m.SetSections([]Section{{Title: "Keys", Bindings: bindings}})
+
+	m, _ = m.Update(tea.KeyPressMsg{Code: 'G', Text: "G"})
+	view := ansi.Strip(m.View())
+	if !strings.Contains(view, "action j") || strings.Contains(view, "action a") {
+		t.Fatalf("expected view scrolled to the end:\n%s", view)
+	}
+	if !strings.Contains(view, "8-11/11") {
+		t.Fatalf("expected scroll position in meta:\n%s", view)
+	}
+
+	m, _ = m.Update(tea.KeyPressMsg{Code: 'g', Text: "g"})
+	if view := ansi.Strip(m.View()); !strings.Contains(view, "Keys") {
+		t.Fatalf("expected view scrolled to the top:\n%s", view)
+	}
+}
@@ -2,16 +2,19 @@
 package jobdetail
 
 import (
+	"bytes"
+	"encoding/json"
 	"fmt"
 	"math"
+	"slices"
 	"strings"
-	"time"
 
 	"charm.land/bubbles/v2/key"
 	tea "charm.land/bubbletea/v2"
 	"charm.land/lipgloss/v2"
 	"github.com/charmbracelet/x/ansi"
 	"github.com/kpumuk/lazykiq/internal/sidekiq"
+	"github.com/kpumuk/lazykiq/internal/ui/clipboard"
 	"github.com/kpumuk/lazykiq/internal/ui/components/frame"
 	"github.com/kpumuk/lazykiq/internal/ui/components/jsonview"
 	"github.com/kpumuk/lazykiq/internal/ui/format"
@@ -28,6 +31,21 @@ type KeyMap struct {
 	GotoBottom  key.Binding
 	Home        key.Binding
 	End         key.Binding
+	Edit        key.Binding
+	EditEnqueue key.Binding
+	CopyJID     key.Binding
+	CopyArgs    key.Binding
+	CopyPayload key.Binding
+	CopyError   key.Binding
+	OpenBatch   key.Binding
+
+	ToggleBacktrace     key.Binding
+	ToggleLibraryFrames key.Binding
+	OpenFrame           key.Binding
+
+	// Close is matched by the parent view, which owns the list behind the
+	// job detail.
+	Close key.Binding
 }
 
 // DefaultKeyMap returns default keybindings.
@@ -69,9 +87,89 @@ func DefaultKeyMap() KeyMap {
 			key.WithKeys("end", "$"),
 			key.WithHelp("$", "scroll to end"),
 		),
+		Edit: key.NewBinding(
+			key.WithKeys("e"),
+			key.WithHelp("e", "edit job"),
+		),
+		EditEnqueue: key.NewBinding(
+			key.WithKeys("E"),
+			key.WithHelp("E", "edit and enqueue"),
+		),
+		CopyJID: key.NewBinding(
+			key.WithKeys("y"),
+			key.WithHelp("y", "copy JID"),
+		),
+		CopyArgs: key.NewBinding(
+			key.WithKeys("A"),
+			key.WithHelp("A", "copy args"),
+		),
+		CopyPayload: key.NewBinding(
+			key.WithKeys("Y"),
+			key.WithHelp("Y", "copy payload"),
+		),
+		CopyError: key.NewBinding(
+			key.WithKeys("B"),
+			key.WithHelp("B", "copy error and backtrace"),
+		),
+		OpenBatch: key.NewBinding(
+			key.WithKeys("b"),
+			key.WithHelp("b", "open batch"),
+		),
+		ToggleBacktrace: key.NewBinding(
+			key.WithKeys("t"),
+			key.WithHelp("t", "toggle backtrace"),
+		),
+		ToggleLibraryFrames: key.NewBinding(
+			key.WithKeys("f"),
+			key.WithHelp("f", "hide gem frames"),
+		),
+		OpenFrame: key.NewBinding(
+			key.WithKeys("o"),
+			key.WithHelp("o", "open frame in editor"),
+		),
+		Close: key.NewBinding(
+			key.WithKeys("esc"),
+			key.WithHelp("esc", "back to list"),
+		),
 	}
 }
 
+// Action describes job detail intents handled by the parent view.
+type Action int
+
+const (
+	// ActionNone indicates no action.
+	ActionNone Action = iota
+	// ActionEdit edits the job payload and saves it in place.
+	ActionEdit
+	// ActionEditEnqueue edits the job payload and enqueues it for immediate execution.
+	ActionEditEnqueue
+)
+
+// ActionMsg reports an action requested for the displayed job.
+type ActionMsg struct {
+	Action Action
+	Job    *sidekiq.JobRecord
+}
+
+// OpenFrameMsg requests opening a backtrace frame in the external editor.
+// File is the path as reported by the deployment; mapping it to a local
+// checkout is up to the receiver.
+type OpenFrameMsg struct {
+	File string
+	Line int
+}
+
+// OpenBatchMsg requests showing the batch the displayed job belongs to.
+type OpenBatchMsg struct {
+	BID string
+}
+
+// StatusMsg sets the status message shown in the panel border.
+type StatusMsg struct {
+	Text string
+}
+
 // Styles holds styles for the job detail component.
 type Styles struct {
 	Title           lipgloss.Style
@@ -88,6 +186,7 @@ type Styles struct {
 	PanelTitle      lipgloss.Style
 	FocusBorder     lipgloss.Style
 	Muted           lipgloss.Style
+	Selected        lipgloss.Style
 }
 
 // DefaultStyles returns default styles.
@@ -107,6 +206,7 @@ func DefaultStyles() Styles {
 		PanelTitle:      lipgloss.NewStyle().Bold(true),
 		FocusBorder:     lipgloss.NewStyle(),
 		Muted:           lipgloss.NewStyle().Faint(true),
+		Selected:        lipgloss.NewStyle().Reverse(true),
 	}
 }
 
@@ -116,6 +216,15 @@ type PropertyRow struct {
 	Value string
 }
 
+// FullHelp returns keybindings for the expanded help view.
+func (k KeyMap) FullHelp() [][]key.Binding {
+	return [][]key.Binding{
+		{k.SwitchPanel, k.LineUp, k.LineDown, k.ScrollLeft, k.ScrollRight, k.GotoTop, k.GotoBottom, k.Home, k.End},
+		{k.Edit, k.EditEnqueue, k.CopyJID, k.CopyArgs, k.CopyPayload, k.CopyError, k.OpenBatch},
+		{k.ToggleBacktrace, k.ToggleLibraryFrames, k.OpenFrame},
+	}
+}
+
 // Model is the job detail component state.
 type Model struct {
 	KeyMap KeyMap
@@ -127,6 +236,18 @@ type Model struct {
 	job        *sidekiq.JobRecord
 	properties []PropertyRow
 	jsonView   jsonview.Model
+	status     string
+
+	// Backtrace state (right panel shows frames instead of JSON)
+	showBacktrace     bool
+	hideLibraryFrames bool
+	frames            []string
+	frameCursor       int
+
+	// Actions enabled by the parent view
+	actions []Action
+
+	clipboardMode clipboard.Mode
 
 	// Scroll state
 	leftYOffset  int
@@ -135,6 +256,9 @@ type Model struct {
 
 	// Focus state (false = left panel, true = right panel)
 	focusRight bool
+	// blurred renders both panels unfocused, e.g. while a list next to
+	// the detail has focus.
+	blurred bool
 
 	// Calculated dimensions
 	leftWidth   int
@@ -145,6 +269,7 @@ type Model struct {
 const (
 	jobDetailPanelPadding = 1
 	jobDetailValueIndent  = 2
+	mouseWheelLines       = 3
 )
 
 // Option is used to set options in New.
@@ -189,6 +314,13 @@ func WithKeyMap(km KeyMap) Option {
 	}
 }
 
+// WithActions enables actions handled by the parent view.
+func WithActions(actions ...Action) Option {
+	return func(m *Model) {
+		m.actions = actions
+	}
+}
+
 // WithSize sets the dimensions.
 func WithSize(width, height int) Option {
 	return func(m *Model) {
@@ -199,6 +331,11 @@ func WithSize(width, height int) Option {
 	}
 }
 
+// SetClipboardMode sets how copy actions reach the clipboard.
+func (m *Model) SetClipboardMode(mode clipboard.Mode) {
+	m.clipboardMode = mode
+}
+
 // SetStyles sets the styles.
 func (m *Model) SetStyles(s Styles) {
 	m.styles = s
@@ -230,32 +367,113 @@ func (m *Model) SetJob(job *sidekiq.JobRecord) {
 	m.rightYOffset = 0
 	m.rightXOffset = 0
 	m.focusRight = false
+	m.status = ""
+	m.showBacktrace = false
+	m.frameCursor = 0
 
 	m.extractProperties()
 	m.formatJSON()
+	m.filterFrames()
+}
+
+// SetStatus sets a short status message shown in the panel border.
+func (m *Model) SetStatus(status string) {
+	m.status = status
+}
+
+// Job returns the displayed job.
+func (m Model) Job() *sidekiq.JobRecord {
+	return m.job
+}
+
+// Focus renders the focused panel with the focus border.
+func (m *Model) Focus() {
+	m.blurred = false
+}
+
+// Blur renders both panels unfocused.
+func (m *Model) Blur() {
+	m.blurred = true
+}
+
+// HelpBindings returns the bindings active for the current job and mode.
+// Bindings sharing a help entry, like line up and down, are listed once.
+func (m Model) HelpBindings() []key.Binding {
+	k := m.KeyMap
+	candidates := []key.Binding{k.Close, k.SwitchPanel, k.LineUp, k.LineDown, k.ScrollLeft, k.ScrollRight, k.GotoTop, k.GotoBottom, k.Home, k.End}
+	if m.HasAction(ActionEdit) {
+		candidates = append(candidates, k.Edit)
+	}
+	if m.HasAction(ActionEditEnqueue) {
+		candidates = append(candidates, k.EditEnqueue)
+	}
+	candidates = append(candidates, k.CopyJID, k.CopyArgs, k.CopyPayload, k.CopyError)
+	if m.job != nil && m.job.Bid() != "" {
+		candidates = append(candidates, k.OpenBatch)
+	}
+	candidates = append(candidates, k.ToggleBacktrace)
+	if m.showBacktrace {
+		candidates = append(candidates, k.ToggleLibraryFrames, k.OpenFrame)
+	}
+
+	var bindings []key.Binding
+	seen := make(map[key.Help]bool)
+	for _, b := range candidates {
+		if !b.Enabled() || seen[b.Help()] {
+			continue
+		}
+		seen[b.Help()] = true
+		bindings = append(bindings, b)
+	}
+	return bindings
+}
+
+// HasAction reports whether the parent view handles the given action.
+func (m Model) HasAction(action Action) bool {
+	return slices.Contains(m.actions, action)
 }
 
 // Update handles key messages.
 func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
 	switch msg := msg.(type) {
+	case clipboard.CopiedMsg:
+		m.status = msg.Status()
+
+	case StatusMsg:
+		m.status = msg.Text
+
+	case tea.MouseWheelMsg:
+		// Scroll the panel under the pointer
+		m.focusRight = msg.X >= m.leftWidth
+		switch msg.Button {
+		case tea.MouseWheelUp:
+			m.scroll(-mouseWheelLines)
+		case tea.MouseWheelDown:
+			m.scroll(mouseWheelLines)
+		}
+
+	case tea.MouseClickMsg:
+		if msg.Button != tea.MouseLeft {
+			return m, nil
+		}
+		m.focusRight = msg.X >= m.leftWidth
+		// Select the clicked frame (row 0 is the top border)
+		if m.focusRight && m.showBacktrace && msg.Y >= 1 && msg.Y <= m.panelHeight {
+			if idx := m.rightYOffset + msg.Y - 1; idx < len(m.frames) {
+				m.frameCursor = idx
+			}
+		}
+
 	case tea.KeyMsg:
 		switch {
 		case key.Matches(msg, m.KeyMap.SwitchPanel):
 			m.focusRight = !m.focusRight
 
 		case key.Matches(msg, m.KeyMap.LineUp):
-			if m.focusRight {
-				m.rightYOffset = clampZeroMax(m.rightYOffset-1, m.maxRightYOffset())
-			} else {
-				m.leftYOffset = clampZeroMax(m.leftYOffset-1, m.maxLeftYOffset())
-			}
+			m.scroll(-1)
 
 		case key.Matches(msg, m.KeyMap.LineDown):
-			if m.focusRight {
-				m.rightYOffset = clampZeroMax(m.rightYOffset+1, m.maxRightYOffset())
-			} else {
-				m.leftYOffset = clampZeroMax(m.leftYOffset+1, m.maxLeftYOffset())
-			}
+			m.scroll(1)
 
 		case key.Matches(msg, m.KeyMap.ScrollLeft):
 			if m.focusRight {
@@ -268,14 +486,18 @@ func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
 			}
 
 		case key.Matches(msg, m.KeyMap.GotoTop):
-			if m.focusRight {
+			if m.focusRight && m.showBacktrace {
+				m.moveFrameCursor(0)
+			} else if m.focusRight {
 				m.rightYOffset = 0
 			} else {
 				m.leftYOffset = 0
 			}
 
 		case key.Matches(msg, m.KeyMap.GotoBottom):
-			if m.focusRight {
+			if m.focusRight && m.showBacktrace {
+				m.moveFrameCursor(len(m.frames) - 1)
+			} else if m.focusRight {
 				m.rightYOffset = m.maxRightYOffset()
 			} else {
 				m.leftYOffset = m.maxLeftYOffset()
@@ -290,12 +512,196 @@ func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
 			if m.focusRight {
 				m.rightXOffset = m.maxRightXOffset()
 			}
+
+		case key.Matches(msg, m.KeyMap.Edit):
+			return m, m.actionCmd(ActionEdit)
+
+		case key.Matches(msg, m.KeyMap.EditEnqueue):
+			return m, m.actionCmd(ActionEditEnqueue)
+
+		case key.Matches(msg, m.KeyMap.CopyJID):
+			return m, m.copyCmd(copyJID)
+
+		case key.Matches(msg, m.KeyMap.CopyArgs):
+			return m, m.copyCmd(copyArgs)
+
+		case key.Matches(msg, m.KeyMap.CopyPayload):
+			return m, m.copyCmd(copyPayload)
+
+		case key.Matches(msg, m.KeyMap.CopyError):
+			return m, m.copyCmd(copyError)
+
+		case key.Matches(msg, m.KeyMap.OpenBatch):
+			if m.job == nil || m.job.Bid() == "" {
+				return m, nil
+			}
+			bid := m.job.Bid()
+			return m, func() tea.Msg {
+				return OpenBatchMsg{BID: bid}
+			}
+
+		case key.Matches(msg, m.KeyMap.ToggleBacktrace):
+			if m.job == nil || len(m.job.ErrorBacktrace()) == 0 {
+				return m, nil
+			}
+			m.showBacktrace = !m.showBacktrace
+			m.focusRight = m.showBacktrace
+			m.rightYOffset = 0
+			m.rightXOffset = 0
+			if m.showBacktrace {
+				m.moveFrameCursor(m.frameCursor)
+			}
+
+		case key.Matches(msg, m.KeyMap.ToggleLibraryFrames):
+			if !m.showBacktrace {
+				return m, nil
+			}
+			m.hideLibraryFrames = !m.hideLibraryFrames
+			m.filterFrames()
+			m.rightXOffset = 0
+			m.moveFrameCursor(0)
+
+		case key.Matches(msg, m.KeyMap.OpenFrame):
+			return m, m.openFrameCmd()
 		}
 	}
 
 	return m, nil
 }
 
+// scroll moves the focused panel by delta lines, or the frame cursor when
+// the backtrace is shown.
+func (m *Model) scroll(delta int) {
+	switch {
+	case m.focusRight && m.showBacktrace:
+		m.moveFrameCursor(m.frameCursor + delta)
+	case m.focusRight:
+		m.rightYOffset = clampZeroMax(m.rightYOffset+delta, m.maxRightYOffset())
+	default:
+		m.leftYOffset = clampZeroMax(m.leftYOffset+delta, m.maxLeftYOffset())
+	}
+}
+
+// filterFrames rebuilds the visible backtrace frames.
+func (m *Model) filterFrames() {
+	m.frames = nil
+	if m.job == nil {
+		return
+	}
+	for _, line := range m.job.ErrorBacktrace() {
+		if m.hideLibraryFrames && sidekiq.IsLibraryFrame(line) {
+			continue
+		}
+		m.frames = append(m.frames, line)
+	}
+}
+
+// moveFrameCursor selects a backtrace frame and scrolls it into view.
+func (m *Model) moveFrameCursor(cursor int) {
+	m.frameCursor = clampZeroMax(cursor, max(len(m.frames)-1, 0))
+	if m.frameCursor < m.rightYOffset {
+		m.rightYOffset = m.frameCursor
+	}
+	if m.frameCursor >= m.rightYOffset+m.panelHeight {
+		m.rightYOffset = m.frameCursor - m.panelHeight + 1
+	}
+	m.rightYOffset = clampZeroMax(m.rightYOffset, m.maxRightYOffset())
+}
+
+// openFrameCmd requests opening the selected backtrace frame in the editor.
+func (m Model) openFrameCmd() tea.Cmd {
+	if !m.showBacktrace || m.frameCursor >= len(m.frames) {
+		return nil
+	}
+	bt, ok := sidekiq.ParseBacktraceFrame(m.frames[m.frameCursor])
+	if !ok {
+		return func() tea.Msg {
+			return StatusMsg{Text: "Not a file frame"}
+		}
+	}
+	return func() tea.Msg {
+		return OpenFrameMsg{File: bt.File, Line: bt.Line}
+	}
+}
+
+func (m Model) actionCmd(action Action) tea.Cmd {
+	if m.job == nil || !m.HasAction(action) {
+		return nil
+	}
+	job := m.job
+	return func() tea.Msg {
+		return ActionMsg{Action: action, Job: job}
+	}
+}
+
+// copyTarget is a part of the displayed job that can be copied.
+type copyTarget int
+
+const (
+	copyJID copyTarget = iota
+	copyArgs
+	copyPayload
+	copyError
+)
+
+// label names the copied part in the status line and the fallback file.
+func (t copyTarget) label() string {
+	switch t {
+	case copyJID:
+		return "JID"
+	case copyArgs:
+		return "Args"
+	case copyPayload:
+		return "Payload"
+	case copyError:
+		return "Error"
+	}
+	return ""
+}
+
+// copyCmd copies a part of the displayed job to the clipboard. Args and the
+// payload are copied as stored, only indented.
+func (m Model) copyCmd(target copyTarget) tea.Cmd {
+	if m.job == nil {
+		return nil
+	}
+
+	var text string
+	switch target {
+	case copyJID:
+		text = m.job.JID()
+	case copyArgs:
+		var payload struct {
+			Args json.RawMessage `json:"args"`
+		}
+		if err := json.Unmarshal([]byte(m.job.Value()), &payload); err == nil {
+			text = indentJSON(payload.Args)
+		}
+	case copyPayload:
+		text = indentJSON([]byte(m.job.Value()))
+	case copyError:
+		if !m.job.HasError() {
+			return nil
+		}
+		lines := append([]string{m.job.ErrorClass() + ": " + m.job.ErrorMessage()}, m.job.ErrorBacktrace()...)
+		text = strings.Join(lines, "\n")
+	}
+	if text == "" {
+		return nil
+	}
+	return clipboard.Copy(m.clipboardMode, target.label(), text)
+}
+
+// indentJSON pretty-prints raw JSON for copying, keeping it unchanged when
+// it cannot be parsed.
+func indentJSON(data []byte) string {
+	var indented bytes.Buffer
+	if err := json.Indent(&indented, data, "", "  "); err != nil {
+		return string(data)
+	}
+	return indented.String()
+}
+
 // View renders the job detail view.
 func (m Model) View() string {
 	if m.job == nil {
@@ -328,7 +734,7 @@ func (m Model) maxLeftYOffset() int {
 }
 
 func (m Model) maxRightYOffset() int {
-	maxY := m.jsonView.LineCount() - m.panelHeight
+	maxY := m.rightLineCount() - m.panelHeight
 	if maxY < 0 {
 		return 0
 	}
@@ -337,13 +743,33 @@ func (m Model) maxRightYOffset() int {
 
 func (m Model) maxRightXOffset() int {
 	contentWidth := max(m.rightWidth-2-2*jobDetailPanelPadding, 0)
-	maxX := m.jsonView.MaxWidth() - contentWidth
+	maxX := m.rightMaxWidth() - contentWidth
 	if maxX < 0 {
 		return 0
 	}
 	return maxX
 }
 
+// rightLineCount returns the number of lines in the right panel.
+func (m Model) rightLineCount() int {
+	if m.showBacktrace {
+		return len(m.frames)
+	}
+	return m.jsonView.LineCount()
+}
+
+// rightMaxWidth returns the widest line in the right panel.
+func (m Model) rightMaxWidth() int {
+	if !m.showBacktrace {
+		return m.jsonView.MaxWidth()
+	}
+	width := 0
+	for _, line := range m.frames {
+		width = max(width, lipgloss.Width(line))
+	}
+	return width
+}
+
 func clampZeroMax(value, maxValue int) int {
 	if value < 0 {
 		return 0
@@ -417,13 +843,13 @@ func (m *Model) extractProperties() {
 	if enqueuedAt := m.job.EnqueuedAt(); enqueuedAt > 0 {
 		m.properties = append(m.properties, PropertyRow{
 			Label: "Enqueued At",
-			Value: formatTimestamp(enqueuedAt),
+			Value: format.Timestamp(enqueuedAt),
 		})
 	}
 	if createdAt := m.job.CreatedAt(); createdAt > 0 {
 		m.properties = append(m.properties, PropertyRow{
 			Label: "Created At",
-			Value: formatTimestamp(createdAt),
+			Value: format.Timestamp(createdAt),
 		})
 	}
 	if latency := m.job.Latency(); latency > 0 {
@@ -453,13 +879,13 @@ func (m *Model) extractProperties() {
 	if failedAt := m.job.FailedAt(); failedAt > 0 {
 		m.properties = append(m.properties, PropertyRow{
 			Label: "Failed At",
-			Value: formatTimestamp(failedAt),
+			Value: format.Timestamp(failedAt),
 		})
 	}
 	if retriedAt := m.job.RetriedAt(); retriedAt > 0 {
 		m.properties = append(m.properties, PropertyRow{
 			Label: "Retried At",
-			Value: formatTimestamp(retriedAt),
+			Value: format.Timestamp(retriedAt),
 		})
 	}
 	if backtrace := m.job.ErrorBacktrace(); len(backtrace) > 0 {
@@ -524,6 +950,11 @@ func (m Model) renderLeftPanel() string {
 	for len(contentLines) < m.panelHeight {
 		contentLines = append(contentLines, "")
 	}
+
+	meta := ""
+	if m.status != "" {
+		meta = m.styles.Muted.Render(m.status)
+	}
 	return frame.New(
 		frame.WithStyles(frame.Styles{
 			Focused: frame.StyleState{
@@ -537,20 +968,36 @@ func (m Model) renderLeftPanel() string {
 		}),
 		frame.WithTitle("Job Details"),
 		frame.WithTitlePadding(0),
+		frame.WithMeta(meta),
+		frame.WithMetaPadding(0),
 		frame.WithContent(strings.Join(contentLines, "\n")),
 		frame.WithPadding(jobDetailPanelPadding),
 		frame.WithSize(m.leftWidth, m.height),
-		frame.WithFocused(!m.focusRight),
+		frame.WithFocused(!m.blurred && !m.focusRight),
 	).View()
 }
 
-// renderRightPanel renders the JSON panel.
+// renderRightPanel renders the JSON panel, or the backtrace when toggled.
 func (m Model) renderRightPanel() string {
 	innerWidth := m.rightWidth - 2 // minus left and right border
 	contentWidth := max(innerWidth-2*jobDetailPanelPadding, 0)
 
+	title := "Job Data (JSON)"
+	var meta string
+	if closeKey := m.KeyMap.Close.Help().Key; m.KeyMap.Close.Enabled() && closeKey != "" {
+		meta = m.styles.Muted.Render(strings.ToUpper(closeKey[:1]) + closeKey[1:] + " to close")
+	}
+	if m.showBacktrace {
+		title = "Backtrace"
+		metaText := fmt.Sprintf("%d frames", len(m.frames))
+		if hidden := len(m.job.ErrorBacktrace()) - len(m.frames); hidden > 0 {
+			metaText = fmt.Sprintf("%s, %d hidden", metaText, hidden)
+		}
+		meta = m.styles.Muted.Render(metaText)
+	}
+
 	// Content lines with horizontal scroll
-	endY := min(m.rightYOffset+m.panelHeight, m.jsonView.LineCount())
+	endY := min(m.rightYOffset+m.panelHeight, m.rightLineCount())
 	contentCap := 0
 	if endY > m.rightYOffset {
 		contentCap = endY - m.rightYOffset
@@ -558,7 +1005,11 @@ func (m Model) renderRightPanel() string {
 	contentLines := make([]string, 0, contentCap)
 
 	for i := m.rightYOffset; i < endY; i++ {
-		contentLines = append(contentLines, m.jsonView.RenderLine(i, m.rightXOffset, contentWidth))
+		if m.showBacktrace {
+			contentLines = append(contentLines, m.renderFrame(i, contentWidth))
+		} else {
+			contentLines = append(contentLines, m.jsonView.RenderLine(i, m.rightXOffset, contentWidth))
+		}
 	}
 
 	// Pad to panel height
@@ -576,27 +1027,28 @@ func (m Model) renderRightPanel() string {
 				Border: m.styles.Border,
 			},
 		}),
-		frame.WithTitle("Job Data (JSON)"),
+		frame.WithTitle(title),
 		frame.WithTitlePadding(0),
-		frame.WithMeta(m.styles.Muted.Render("Esc to close")),
+		frame.WithMeta(meta),
 		frame.WithMetaPadding(0),
 		frame.WithContent(strings.Join(contentLines, "\n")),
 		frame.WithPadding(jobDetailPanelPadding),
 		frame.WithSize(m.rightWidth, m.height),
-		frame.WithFocused(m.focusRight),
+		frame.WithFocused(!m.blurred && m.focusRight),
 	).View()
 }
 
-// formatTimestamp formats a Unix timestamp.
-func formatTimestamp(ts float64) string {
-	// Handle both seconds and milliseconds
-	var t time.Time
-	if ts > 1e12 {
-		t = time.UnixMilli(int64(ts))
-	} else {
-		t = time.Unix(int64(ts), 0)
+// renderFrame renders a backtrace frame, highlighting the selected one.
+func (m Model) renderFrame(index, width int) string {
+	line := ansi.Cut(m.frames[index], m.rightXOffset, m.rightXOffset+width)
+	switch {
+	case index == m.frameCursor:
+		return m.styles.Selected.Width(width).Render(line)
+	case sidekiq.IsLibraryFrame(m.frames[index]):
+		return m.styles.Muted.Render(line)
+	default:
+		return m.styles.Value.Render(line)
 	}
-	return t.Format("2006-01-02 15:04:05")
 }
 
 // wrapText wraps text to fit within the specified width.
@@ -19,6 +19,9 @@ type Data struct {
 	Retries   int64
 	Scheduled int64
 	Dead      int64
+
+	Processes      int64
+	StaleProcesses int64
 }
 
 // UpdateMsg is sent when metrics should be updated.
@@ -145,6 +148,12 @@ func (m Model) View() string {
 		m.styles.Label.Render("Scheduled: ") + m.styles.Value.Render(format.Number(m.data.Scheduled)),
 		m.styles.Label.Render("Dead: ") + m.styles.Value.Render(format.Number(m.data.Dead)),
 	}
+	// Stale processes only show up when there are any, as a prompt to
+	// clean them up from the Busy view.
+	if m.data.StaleProcesses > 0 {
+		baseMetrics = append(baseMetrics, m.styles.Label.Render("Stale: ")+
+			m.styles.Value.Render(format.Number(m.data.StaleProcesses)+"/"+format.Number(m.data.Processes)))
+	}
 
 	if m.width <= 0 || len(baseMetrics) == 0 {
 		return barStyle.Render("")
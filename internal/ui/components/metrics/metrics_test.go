@@ -74,3 +74,15 @@ func TestViewSnapshots(t *testing.T) {
 		})
 	}
 }
+
+func TestViewStaleProcesses(t *testing.T) {
+	data := testData()
+	data.Processes = 4
+	data.StaleProcesses = 1
+
+	m := New(WithStyles(testStyles()), WithWidth(120), WithData(data))
+	expected := " Processed: 1   Failed: 22    Busy: 333     Enqueued: 4.4K   Retries: 55.6K   Scheduled: 6   Dead: 7.8M    Stale: 1/4   "
+	if got := m.View(); got != expected {
+		t.Fatalf("unexpected output:\nexpected %q\ngot      %q", expected, got)
+	}
+}
@@ -12,6 +12,7 @@ import (
 // ViewInfo holds information about a view for display in the navbar.
 type ViewInfo struct {
 	Name string
+	Key  string
 }
 
 // Styles holds the styles needed by the navbar.
@@ -34,9 +35,10 @@ func DefaultStyles() Styles {
 
 // Model defines state for the navbar component.
 type Model struct {
-	styles Styles
-	views  []ViewInfo
-	width  int
+	styles  Styles
+	views   []ViewInfo
+	quitKey string
+	width   int
 }
 
 // Option is used to set options in New.
@@ -45,7 +47,8 @@ type Option func(*Model)
 // New creates a new navbar model.
 func New(opts ...Option) Model {
 	m := Model{
-		styles: DefaultStyles(),
+		styles:  DefaultStyles(),
+		quitKey: "q",
 	}
 
 	for _, opt := range opts {
@@ -69,6 +72,13 @@ func WithViews(views []ViewInfo) Option {
 	}
 }
 
+// WithQuitKey sets the key shown in the quit hint.
+func WithQuitKey(k string) Option {
+	return func(m *Model) {
+		m.quitKey = k
+	}
+}
+
 // WithWidth sets the width.
 func WithWidth(w int) Option {
 	return func(m *Model) {
@@ -111,19 +121,40 @@ func (m Model) Update(_ tea.Msg) (Model, tea.Cmd) {
 	return m, nil
 }
 
+// ViewAt returns the index of the view item rendered at column x,
+// or -1 when x is outside all view items.
+func (m Model) ViewAt(x int) int {
+	pos := m.styles.Bar.GetPaddingLeft()
+	for i := range m.views {
+		width := lipgloss.Width(m.renderItem(i))
+		if x >= pos && x < pos+width {
+			return i
+		}
+		pos += width
+	}
+	return -1
+}
+
 // View renders the navbar.
 func (m Model) View() string {
 	barStyle := m.styles.Bar.Width(m.width)
 
 	var items strings.Builder
-	for i, v := range m.views {
-		key := m.styles.Key.Render(fmt.Sprintf("%d", i+1))
-		name := m.styles.Item.Render(v.Name)
-		items.WriteString(key + name)
+	for i := range m.views {
+		items.WriteString(m.renderItem(i))
 	}
 
 	// Add quit hint
-	items.WriteString(m.styles.Key.Render("q") + m.styles.Quit.Render("quit"))
+	items.WriteString(m.styles.Key.Render(m.quitKey) + m.styles.Quit.Render("quit"))
 
 	return barStyle.Render(items.String())
 }
+
+func (m Model) renderItem(i int) string {
+	v := m.views[i]
+	k := v.Key
+	if k == "" {
+		k = fmt.Sprintf("%d", i+1)
+	}
+	return m.styles.Key.Render(k) + m.styles.Item.Render(v.Name)
+}
@@ -0,0 +1,35 @@
+// Package sparkline renders compact single-line charts.
+package sparkline
+
+import "strings"
+
+// levels are the block characters used for increasing values.
+var levels = []rune("▁▂▃▄▅▆▇█")
+
+// Render draws the last width values as a sparkline scaled from zero to the
+// largest value. Shorter series are right-aligned and padded with spaces.
+func Render(values []float64, width int) string {
+	if width <= 0 {
+		return ""
+	}
+	if len(values) > width {
+		values = values[len(values)-width:]
+	}
+
+	maxValue := 0.0
+	for _, v := range values {
+		maxValue = max(maxValue, v)
+	}
+
+	var b strings.Builder
+	b.WriteString(strings.Repeat(" ", width-len(values)))
+	for _, v := range values {
+		level := 0
+		if maxValue > 0 && v > 0 {
+			level = int(v / maxValue * float64(len(levels)-1))
+			level = min(max(level, 0), len(levels)-1)
+		}
+		b.WriteRune(levels[level])
+	}
+	return b.String()
+}
@@ -0,0 +1,27 @@
+package sparkline
+
+import "testing"
+
+func TestRender(t *testing.T) {
+	tests := []struct {
+		name   string
+		values []float64
+		width  int
+		want   string
+	}{
+		{name: "Empty", values: nil, width: 3, want: "   "},
+		{name: "Zeros", values: []float64{0, 0}, width: 2, want: "▁▁"},
+		{name: "Scaled", values: []float64{0, 7, 14}, width: 3, want: "▁▄█"},
+		{name: "PadShort", values: []float64{1}, width: 3, want: "  █"},
+		{name: "KeepLatest", values: []float64{14, 0, 14}, width: 2, want: "▁█"},
+		{name: "ZeroWidth", values: []float64{1}, width: 0, want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Render(tt.values, tt.width); got != tt.want {
+				t.Fatalf("Render(%v, %d) = %q, want %q", tt.values, tt.width, got, tt.want)
+			}
+		})
+	}
+}
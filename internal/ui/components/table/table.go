@@ -79,6 +79,14 @@ func DefaultKeyMap() KeyMap {
 	}
 }
 
+// FullHelp returns keybindings for the expanded help view.
+func (k KeyMap) FullHelp() [][]key.Binding {
+	return [][]key.Binding{
+		{k.LineUp, k.LineDown, k.PageUp, k.PageDown, k.GotoTop, k.GotoBottom},
+		{k.ScrollLeft, k.ScrollRight, k.Home, k.End},
+	}
+}
+
 // Styles holds the styles needed by the table.
 type Styles struct {
 	Text      lipgloss.Style
@@ -86,6 +94,8 @@ type Styles struct {
 	Header    lipgloss.Style
 	Selected  lipgloss.Style
 	Separator lipgloss.Style
+	// Highlight styles rows marked with SetHighlighted, unless selected.
+	Highlight lipgloss.Style
 }
 
 // DefaultStyles returns a set of default style definitions for this table.
@@ -99,6 +109,13 @@ func DefaultStyles() Styles {
 	}
 }
 
+const (
+	// headerHeight is the number of lines taken by the header and separator.
+	headerHeight = 2
+	// mouseWheelRows is the number of rows moved per mouse wheel step.
+	mouseWheelRows = 3
+)
+
 // Model is a scrollable table component with selection support.
 type Model struct {
 	KeyMap KeyMap
@@ -115,6 +132,7 @@ type Model struct {
 	colWidths      []int // dynamic column widths (max of defined and actual)
 	lastColWidth   int
 	emptyMessage   string
+	highlighted    []bool
 	content        string // pre-rendered body content
 	viewportHeight int
 }
@@ -178,7 +196,7 @@ func WithWidth(w int) Option {
 func WithHeight(h int) Option {
 	return func(m *Model) {
 		m.height = h
-		m.viewportHeight = max(h-2, 1) // minus header and separator
+		m.viewportHeight = max(h-headerHeight, 1)
 	}
 }
 
@@ -199,7 +217,7 @@ func (m *Model) SetStyles(s Styles) {
 func (m *Model) SetSize(width, height int) {
 	m.width = width
 	m.height = height
-	m.viewportHeight = max(height-2, 1) // minus header and separator
+	m.viewportHeight = max(height-headerHeight, 1)
 	m.updateViewport()
 	m.clampScroll()
 }
@@ -218,6 +236,13 @@ func (m *Model) SetRows(rows []Row) {
 	m.clampScroll()
 }
 
+// SetHighlighted marks rows to render with the Highlight style, by index.
+// Call it after SetRows, as highlights are not moved along with rows.
+func (m *Model) SetHighlighted(highlighted []bool) {
+	m.highlighted = highlighted
+	m.updateViewport()
+}
+
 // SetColumns sets a new columns state.
 func (m *Model) SetColumns(cols []Column) {
 	m.columns = cols
@@ -300,10 +325,34 @@ func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
 		case key.Matches(msg, m.KeyMap.End):
 			m.ScrollToEnd()
 		}
+
+	case tea.MouseWheelMsg:
+		switch msg.Button {
+		case tea.MouseWheelUp:
+			m.MoveUp(mouseWheelRows)
+		case tea.MouseWheelDown:
+			m.MoveDown(mouseWheelRows)
+		case tea.MouseWheelLeft:
+			m.ScrollLeft()
+		case tea.MouseWheelRight:
+			m.ScrollRight()
+		}
 	}
 	return m, nil
 }
 
+// ClickRow selects the row displayed at line y, relative to the top of the
+// table (including the header). It reports whether a row was hit.
+func (m *Model) ClickRow(y int) bool {
+	row := m.yOffset + y - headerHeight
+	if y < headerHeight || y >= headerHeight+m.viewportHeight || row >= len(m.rows) {
+		return false
+	}
+	m.cursor = row
+	m.updateViewport()
+	return true
+}
+
 // View renders the table (header + visible rows).
 func (m Model) View() string {
 	header := m.renderHeader()
@@ -537,9 +586,12 @@ func (m *Model) renderBody() string {
 		row = applyHorizontalScroll(row, m.xOffset, m.width)
 
 		// Apply selection highlight
-		if i == m.cursor {
+		switch {
+		case i == m.cursor:
 			row = m.styles.Selected.Render(row)
-		} else {
+		case i < len(m.highlighted) && m.highlighted[i]:
+			row = m.styles.Highlight.Render(row)
+		default:
 			row = m.styles.Text.Render(row)
 		}
 
@@ -505,6 +505,29 @@ func TestSetStyles_RefreshesContent(t *testing.T) {
 	}
 }
 
+func TestSetHighlighted(t *testing.T) {
+	styles := blankStyles()
+	styles.Highlight = lipgloss.NewStyle().Bold(true)
+	table := New(
+		WithColumns([]Column{{Title: "A", Width: 1}}),
+		WithRows([]Row{{"1"}, {"2"}, {"3"}}),
+		WithStyles(styles),
+		WithWidth(4),
+		WithHeight(5),
+	)
+	table.SetHighlighted([]bool{true, true})
+
+	lines := strings.Split(table.View(), "\n")
+	bold := styles.Highlight.Render("2   ")
+	if lines[3] != bold {
+		t.Fatalf("highlighted row = %q, want %q", lines[3], bold)
+	}
+	// The selected row keeps the selection style, rows past the flags are plain
+	if lines[2] != "1   " || lines[4] != "3   " {
+		t.Fatalf("rows = %q, %q, want plain", lines[2], lines[4])
+	}
+}
+
 func TestSetRows_ClampsHorizontalScroll(t *testing.T) {
 	table := New(
 		WithColumns([]Column{
@@ -742,3 +765,52 @@ func TestView_SetColumnsSnapshot(t *testing.T) {
 		t.Fatalf("want %q, got %q", want, got)
 	}
 }
+
+func TestClickRow(t *testing.T) {
+	rows := make([]Row, 20)
+	for i := range rows {
+		rows[i] = Row{strings.Repeat("x", i+1)}
+	}
+	m := New(
+		WithColumns([]Column{{Title: "Col", Width: 10}}),
+		WithRows(rows),
+		WithHeight(7), // header + separator + 5 rows
+		WithWidth(20),
+	)
+
+	if m.ClickRow(1) {
+		t.Fatal("ClickRow(1) on separator = true, want false")
+	}
+	if !m.ClickRow(4) || m.Cursor() != 2 {
+		t.Fatalf("ClickRow(4) cursor = %d, want 2", m.Cursor())
+	}
+
+	m.MoveDown(10) // scrolls so row 12 is the last visible row
+	if !m.ClickRow(2) || m.Cursor() != 8 {
+		t.Fatalf("ClickRow(2) after scroll cursor = %d, want 8", m.Cursor())
+	}
+	if m.ClickRow(7) {
+		t.Fatal("ClickRow(7) below viewport = true, want false")
+	}
+}
+
+func TestMouseWheel(t *testing.T) {
+	rows := make([]Row, 20)
+	for i := range rows {
+		rows[i] = Row{"x"}
+	}
+	m := New(
+		WithColumns([]Column{{Title: "Col", Width: 10}}),
+		WithRows(rows),
+		WithHeight(7),
+	)
+
+	m, _ = m.Update(tea.MouseWheelMsg{Button: tea.MouseWheelDown})
+	if m.Cursor() != mouseWheelRows {
+		t.Fatalf("cursor after wheel down = %d, want %d", m.Cursor(), mouseWheelRows)
+	}
+	m, _ = m.Update(tea.MouseWheelMsg{Button: tea.MouseWheelUp})
+	if m.Cursor() != 0 {
+		t.Fatalf("cursor after wheel up = %d, want 0", m.Cursor())
+	}
+}
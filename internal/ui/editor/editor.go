@@ -0,0 +1,46 @@
+// Package editor launches the user's external text editor.
+package editor
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// Editor returns the editor configured via $VISUAL or $EDITOR, falling back to vi.
+func Editor() []string {
+	for _, env := range []string{"VISUAL", "EDITOR"} {
+		if fields := strings.Fields(os.Getenv(env)); len(fields) > 0 {
+			return fields
+		}
+	}
+	return []string{"vi"}
+}
+
+// Command returns a command that opens file in the user's editor.
+// When line is positive, the editor is asked to jump to that line.
+func Command(file string, line int) *exec.Cmd {
+	editor := Editor()
+	args := append(editor[1:], Args(editor[0], file, line)...)
+	return exec.Command(editor[0], args...) //nolint:gosec // editor comes from the user's environment
+}
+
+// Args returns the arguments that open file at line for the given editor binary.
+func Args(editor, file string, line int) []string {
+	if line <= 0 {
+		return []string{file}
+	}
+
+	location := file + ":" + strconv.Itoa(line)
+	switch filepath.Base(editor) {
+	case "code", "code-insiders", "codium", "cursor":
+		return []string{"--goto", location}
+	case "subl", "zed", "hx", "helix":
+		return []string{location}
+	default:
+		// vi, vim, nvim, nano, emacs, micro, kak and most others accept +LINE.
+		return []string{"+" + strconv.Itoa(line), file}
+	}
+}
@@ -0,0 +1,39 @@
+package editor
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestArgs(t *testing.T) {
+	tests := []struct {
+		editor string
+		line   int
+		want   []string
+	}{
+		{editor: "vim", line: 0, want: []string{"a.rb"}},
+		{editor: "vim", line: 12, want: []string{"+12", "a.rb"}},
+		{editor: "/usr/bin/nvim", line: 3, want: []string{"+3", "a.rb"}},
+		{editor: "code", line: 12, want: []string{"--goto", "a.rb:12"}},
+		{editor: "subl", line: 7, want: []string{"a.rb:7"}},
+	}
+
+	for _, tt := range tests {
+		if got := Args(tt.editor, "a.rb", tt.line); !slices.Equal(got, tt.want) {
+			t.Errorf("Args(%q, a.rb, %d) = %v, want %v", tt.editor, tt.line, got, tt.want)
+		}
+	}
+}
+
+func TestEditor(t *testing.T) {
+	t.Setenv("VISUAL", "")
+	t.Setenv("EDITOR", "code --wait")
+	if got := Editor(); !slices.Equal(got, []string{"code", "--wait"}) {
+		t.Fatalf("Editor() = %v, want [code --wait]", got)
+	}
+
+	t.Setenv("EDITOR", "")
+	if got := Editor(); !slices.Equal(got, []string{"vi"}) {
+		t.Fatalf("Editor() = %v, want [vi]", got)
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"strings"
+	"time"
 )
 
 // Duration formats elapsed seconds as "2m3s", "1h30m", etc. (max 2 segments).
@@ -30,6 +31,17 @@ func Duration(seconds int64) string {
 	}
 }
 
+// Timestamp formats a Sidekiq timestamp given in seconds or milliseconds.
+func Timestamp(ts float64) string {
+	var t time.Time
+	if ts > 1e12 {
+		t = time.UnixMilli(int64(ts))
+	} else {
+		t = time.Unix(int64(ts), 0)
+	}
+	return t.Format("2006-01-02 15:04:05")
+}
+
 // Bytes formats bytes as "168 MB", "1.2 GB", etc.
 func Bytes(bytes int64) string {
 	const unit = 1024
@@ -3,6 +3,7 @@ package format
 import (
 	"errors"
 	"testing"
+	"time"
 )
 
 type badJSON struct{}
@@ -141,3 +142,24 @@ func TestShortNumber(t *testing.T) {
 		})
 	}
 }
+
+func TestTimestamp(t *testing.T) {
+	want := time.Unix(1_700_000_000, 0).Format("2006-01-02 15:04:05")
+
+	tests := []struct {
+		name string
+		ts   float64
+	}{
+		{name: "seconds", ts: 1_700_000_000},
+		{name: "fractional-seconds", ts: 1_700_000_000.5},
+		{name: "milliseconds", ts: 1_700_000_000_000},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Timestamp(tt.ts); got != want {
+				t.Fatalf("Timestamp(%v) = %q, want %q", tt.ts, got, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,129 @@
+// Package keymap applies user key binding overrides to component key maps
+// and detects conflicting bindings.
+package keymap
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+	"unicode"
+
+	"charm.land/bubbles/v2/key"
+)
+
+// Binding is a named key binding within a scope (e.g. "table.line_down").
+type Binding struct {
+	Scope   string
+	Name    string
+	Binding key.Binding
+}
+
+// String returns the qualified binding name.
+func (b Binding) String() string {
+	return b.Scope + "." + b.Name
+}
+
+// Apply overrides bindings of km, a pointer to a struct of key.Binding fields,
+// using snake_case field names (e.g. "line_down" for LineDown). The help
+// text keeps its description and shows the new keys.
+func Apply(km any, overrides map[string][]string) error {
+	v := reflect.ValueOf(km)
+	if v.Kind() != reflect.Pointer || v.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("keymap: %T is not a pointer to a struct", km)
+	}
+	v = v.Elem()
+
+	fields := bindingFields(v)
+	for name, keys := range overrides {
+		field, ok := fields[name]
+		if !ok {
+			return fmt.Errorf("unknown binding %q (available: %s)", name, strings.Join(sortedKeys(fields), ", "))
+		}
+		binding := field.Addr().Interface().(*key.Binding)
+		if len(keys) == 0 {
+			binding.SetEnabled(false)
+			continue
+		}
+		binding.SetKeys(keys...)
+		binding.SetHelp(strings.Join(keys, "/"), binding.Help().Desc)
+		binding.SetEnabled(true)
+	}
+	return nil
+}
+
+// Bindings lists the enabled bindings of km, a struct (or pointer to a struct)
+// of key.Binding fields, in field order.
+func Bindings(scope string, km any) []Binding {
+	v := reflect.Indirect(reflect.ValueOf(km))
+	bindingType := reflect.TypeFor[key.Binding]()
+
+	var bindings []Binding
+	for i := range v.NumField() {
+		field := v.Type().Field(i)
+		if field.Type != bindingType || !field.IsExported() {
+			continue
+		}
+		binding := v.Field(i).Interface().(key.Binding)
+		if !binding.Enabled() {
+			continue
+		}
+		bindings = append(bindings, Binding{Scope: scope, Name: SnakeCase(field.Name), Binding: binding})
+	}
+	return bindings
+}
+
+// Conflicts reports keys bound to more than one of the given bindings.
+func Conflicts(bindings []Binding) []string {
+	owners := map[string]Binding{}
+	var conflicts []string
+	for _, b := range bindings {
+		for _, k := range b.Binding.Keys() {
+			if owner, ok := owners[k]; ok && owner.String() != b.String() {
+				conflicts = append(conflicts, fmt.Sprintf("key %q is bound to both %s and %s", k, owner, b))
+				continue
+			}
+			owners[k] = b
+		}
+	}
+	return conflicts
+}
+
+// SnakeCase converts a Go field name to snake_case ("CopyJID" -> "copy_jid").
+func SnakeCase(name string) string {
+	runes := []rune(name)
+	var b strings.Builder
+	for i, r := range runes {
+		if unicode.IsUpper(r) && i > 0 {
+			prev := runes[i-1]
+			nextLower := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+			if unicode.IsLower(prev) || unicode.IsDigit(prev) || (unicode.IsUpper(prev) && nextLower) {
+				b.WriteByte('_')
+			}
+		}
+		b.WriteRune(unicode.ToLower(r))
+	}
+	return b.String()
+}
+
+func bindingFields(v reflect.Value) map[string]reflect.Value {
+	bindingType := reflect.TypeFor[key.Binding]()
+	fields := map[string]reflect.Value{}
+	for i := range v.NumField() {
+		field := v.Type().Field(i)
+		if field.Type != bindingType || !field.IsExported() {
+			continue
+		}
+		fields[SnakeCase(field.Name)] = v.Field(i)
+	}
+	return fields
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	return keys
+}
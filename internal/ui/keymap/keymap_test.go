@@ -0,0 +1,84 @@
+package keymap
+
+import (
+	"slices"
+	"testing"
+
+	"charm.land/bubbles/v2/key"
+)
+
+type testKeyMap struct {
+	LineUp   key.Binding
+	LineDown key.Binding
+	CopyJID  key.Binding
+}
+
+func newTestKeyMap() testKeyMap {
+	return testKeyMap{
+		LineUp:   key.NewBinding(key.WithKeys("up", "k"), key.WithHelp("↑/k", "up")),
+		LineDown: key.NewBinding(key.WithKeys("down", "j"), key.WithHelp("↓/j", "down")),
+		CopyJID:  key.NewBinding(key.WithKeys("y"), key.WithHelp("y", "copy JID")),
+	}
+}
+
+func TestSnakeCase(t *testing.T) {
+	tests := map[string]string{
+		"LineDown":        "line_down",
+		"CopyJID":         "copy_jid",
+		"View1":           "view1",
+		"ShiftTab":        "shift_tab",
+		"JSONPunctuation": "json_punctuation",
+	}
+	for in, want := range tests {
+		if got := SnakeCase(in); got != want {
+			t.Errorf("SnakeCase(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+func TestApply(t *testing.T) {
+	km := newTestKeyMap()
+	err := Apply(&km, map[string][]string{
+		"line_down": {"n"},
+		"copy_jid":  {},
+	})
+	if err != nil {
+		t.Fatalf("Apply() error = %v", err)
+	}
+
+	if got := km.LineDown.Keys(); !slices.Equal(got, []string{"n"}) {
+		t.Errorf("LineDown keys = %v, want [n]", got)
+	}
+	if help := km.LineDown.Help(); help.Key != "n" || help.Desc != "down" {
+		t.Errorf("LineDown help = %+v, want n/down", help)
+	}
+	if km.CopyJID.Enabled() {
+		t.Error("CopyJID enabled, want disabled")
+	}
+	if got := km.LineUp.Keys(); !slices.Equal(got, []string{"up", "k"}) {
+		t.Errorf("LineUp keys = %v, want unchanged", got)
+	}
+}
+
+func TestApply_UnknownBinding(t *testing.T) {
+	km := newTestKeyMap()
+	if err := Apply(&km, map[string][]string{"line_sideways": {"x"}}); err == nil {
+		t.Fatal("Apply() error = nil, want error")
+	}
+}
+
+func TestConflicts(t *testing.T) {
+	km := newTestKeyMap()
+	if got := Conflicts(Bindings("table", km)); len(got) != 0 {
+		t.Fatalf("Conflicts() = %v, want none", got)
+	}
+
+	if err := Apply(&km, map[string][]string{"copy_jid": {"j"}}); err != nil {
+		t.Fatalf("Apply() error = %v", err)
+	}
+	got := Conflicts(Bindings("table", km))
+	want := []string{`key "j" is bound to both table.line_down and table.copy_jid`}
+	if !slices.Equal(got, want) {
+		t.Fatalf("Conflicts() = %v, want %v", got, want)
+	}
+}
@@ -1,19 +1,60 @@
 package ui
 
-import "charm.land/bubbles/v2/key"
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
 
-// KeyMap defines all global keybindings.
+	"charm.land/bubbles/v2/key"
+	"github.com/kpumuk/lazykiq/internal/ui/components/cmdline"
+	"github.com/kpumuk/lazykiq/internal/ui/components/helppopup"
+	"github.com/kpumuk/lazykiq/internal/ui/keymap"
+	"github.com/kpumuk/lazykiq/internal/ui/views"
+)
+
+// Key binding scopes in the config file.
+const (
+	keyScopeApp       = "app"
+	keyScopeHelp      = "help"
+	keyScopeCmdline   = "cmdline"
+	keyScopeTable     = "table"
+	keyScopeJobDetail = "jobdetail"
+	keyScopeFilter    = "filter"
+	keyScopeDateRange = "daterange"
+	keyScopeDashboard = "dashboard"
+	keyScopeQueues    = "queues"
+	keyScopeBusy      = "busy"
+	keyScopeJobs      = "jobs"
+	keyScopeRedis     = "redis"
+	keyScopeMetrics   = "metrics"
+	keyScopeBatches   = "batches"
+)
+
+// keyScopes lists the config scopes in the order they are documented.
+var keyScopes = []string{
+	keyScopeApp, keyScopeHelp, keyScopeCmdline, keyScopeTable, keyScopeJobDetail, keyScopeFilter,
+	keyScopeDateRange, keyScopeDashboard, keyScopeQueues, keyScopeBusy, keyScopeJobs, keyScopeRedis, keyScopeMetrics, keyScopeBatches,
+}
+
+// KeyMap defines all global keybindings. They are matched before keys reach
+// the active view.
 type KeyMap struct {
-	Quit     key.Binding
-	View1    key.Binding
-	View2    key.Binding
-	View3    key.Binding
-	View4    key.Binding
-	View5    key.Binding
-	View6    key.Binding
-	Tab      key.Binding
-	ShiftTab key.Binding
-	Help     key.Binding
+	Quit    key.Binding
+	View1   key.Binding
+	View2   key.Binding
+	View3   key.Binding
+	View4   key.Binding
+	View5   key.Binding
+	View6   key.Binding
+	View7   key.Binding
+	View8   key.Binding
+	View9   key.Binding
+	Help    key.Binding
+	Command key.Binding
+	Theme   key.Binding
+	Mouse   key.Binding
+	Split   key.Binding
 }
 
 // DefaultKeyMap returns the default keybindings.
@@ -47,30 +88,174 @@ func DefaultKeyMap() KeyMap {
 			key.WithKeys("6"),
 			key.WithHelp("6", "dead"),
 		),
-		Tab: key.NewBinding(
-			key.WithKeys("tab"),
-			key.WithHelp("tab", "next panel"),
+		View7: key.NewBinding(
+			key.WithKeys("7"),
+			key.WithHelp("7", "redis"),
 		),
-		ShiftTab: key.NewBinding(
-			key.WithKeys("shift+tab"),
-			key.WithHelp("shift+tab", "prev panel"),
+		View8: key.NewBinding(
+			key.WithKeys("8"),
+			key.WithHelp("8", "metrics"),
+		),
+		View9: key.NewBinding(
+			key.WithKeys("9"),
+			key.WithHelp("9", "batches"),
 		),
 		Help: key.NewBinding(
 			key.WithKeys("?"),
 			key.WithHelp("?", "help"),
 		),
+		Command: key.NewBinding(
+			key.WithKeys(":"),
+			key.WithHelp(":", "command"),
+		),
+		Theme: key.NewBinding(
+			key.WithKeys("T"),
+			key.WithHelp("T", "next theme"),
+		),
+		Mouse: key.NewBinding(
+			key.WithKeys("M"),
+			key.WithHelp("M", "toggle mouse"),
+		),
+		Split: key.NewBinding(
+			key.WithKeys("S"),
+			key.WithHelp("S", "toggle split layout"),
+		),
 	}
 }
 
 // ShortHelp returns keybindings to show in the mini help view.
 func (k KeyMap) ShortHelp() []key.Binding {
-	return []key.Binding{k.View1, k.View2, k.View3, k.View4, k.View5, k.View6, k.Quit}
+	return []key.Binding{k.View1, k.View2, k.View3, k.View4, k.View5, k.View6, k.View7, k.View8, k.View9, k.Quit}
 }
 
 // FullHelp returns keybindings for the expanded help view.
 func (k KeyMap) FullHelp() [][]key.Binding {
 	return [][]key.Binding{
-		{k.View1, k.View2, k.View3, k.View4, k.View5, k.View6},
-		{k.Tab, k.ShiftTab, k.Help, k.Quit},
+		{k.View1, k.View2, k.View3, k.View4, k.View5, k.View6, k.View7, k.View8, k.View9},
+		{k.Command, k.Theme, k.Mouse, k.Split, k.Help, k.Quit},
+	}
+}
+
+// viewKeys returns the view switching bindings in navbar order.
+func (k KeyMap) viewKeys() []key.Binding {
+	return []key.Binding{k.View1, k.View2, k.View3, k.View4, k.View5, k.View6, k.View7, k.View8, k.View9}
+}
+
+// keyMaps holds the key maps of the app, its popups, and its views.
+type keyMaps struct {
+	app     KeyMap
+	help    helppopup.KeyMap
+	cmdline cmdline.KeyMap
+	views   views.KeyMaps
+}
+
+// target returns the key map configured by scope, or nil for unknown scopes.
+func (km *keyMaps) target(scope string) any {
+	switch scope {
+	case keyScopeApp:
+		return &km.app
+	case keyScopeHelp:
+		return &km.help
+	case keyScopeCmdline:
+		return &km.cmdline
+	case keyScopeTable:
+		return &km.views.Table
+	case keyScopeJobDetail:
+		return &km.views.JobDetail
+	case keyScopeFilter:
+		return &km.views.Filter
+	case keyScopeDateRange:
+		return &km.views.DateRange
+	case keyScopeDashboard:
+		return &km.views.Dashboard
+	case keyScopeQueues:
+		return &km.views.Queues
+	case keyScopeBusy:
+		return &km.views.Busy
+	case keyScopeJobs:
+		return &km.views.Jobs
+	case keyScopeRedis:
+		return &km.views.Redis
+	case keyScopeMetrics:
+		return &km.views.Metrics
+	case keyScopeBatches:
+		return &km.views.Batches
+	}
+	return nil
+}
+
+// loadKeyMaps applies config overrides to the default key maps and rejects
+// keys bound to more than one action that can be active at the same time.
+func loadKeyMaps(overrides map[string]map[string][]string) (keyMaps, error) {
+	km := keyMaps{
+		app:     DefaultKeyMap(),
+		help:    helppopup.DefaultKeyMap(),
+		cmdline: cmdline.DefaultKeyMap(),
+		views:   views.DefaultKeyMaps(),
+	}
+
+	for _, scope := range slices.Sorted(maps.Keys(overrides)) {
+		target := km.target(scope)
+		if target == nil {
+			return km, fmt.Errorf("keys.%s: unknown scope (available: %s)", scope, strings.Join(keyScopes, ", "))
+		}
+		if err := keymap.Apply(target, overrides[scope]); err != nil {
+			return km, fmt.Errorf("keys.%s: %w", scope, err)
+		}
+	}
+
+	if conflicts := km.conflicts(); len(conflicts) > 0 {
+		return km, fmt.Errorf("conflicting key bindings:\n  %s", strings.Join(conflicts, "\n  "))
+	}
+	return km, nil
+}
+
+// conflicts checks the global app bindings against each other and against
+// the bindings of each view, together with the components the view passes
+// keys to. The help popup, the command line, the date range picker, and a
+// focused filter capture all keys, so each is checked on its own; the help
+// popup also closes on the app help key.
+func (km keyMaps) conflicts() []string {
+	global := keymap.Bindings(keyScopeApp, km.app)
+	table := keymap.Bindings(keyScopeTable, km.views.Table)
+	filter, focusedFilter := splitBindings(keymap.Bindings(keyScopeFilter, km.views.Filter), "apply", "cancel")
+	_, helpToggle := splitBindings(global, "help")
+
+	var conflicts []string
+	for _, group := range [][]keymap.Binding{
+		global,
+		slices.Concat(helpToggle, keymap.Bindings(keyScopeHelp, km.help)),
+		keymap.Bindings(keyScopeCmdline, km.cmdline),
+		keymap.Bindings(keyScopeDateRange, km.views.DateRange),
+		focusedFilter,
+		slices.Concat(global, table),
+		slices.Concat(global, keymap.Bindings(keyScopeJobDetail, km.views.JobDetail)),
+		slices.Concat(global, keymap.Bindings(keyScopeDashboard, km.views.Dashboard)),
+		slices.Concat(global, keymap.Bindings(keyScopeQueues, km.views.Queues), table),
+		slices.Concat(global, keymap.Bindings(keyScopeBusy, km.views.Busy), table),
+		slices.Concat(global, keymap.Bindings(keyScopeJobs, km.views.Jobs), filter, table),
+		slices.Concat(global, keymap.Bindings(keyScopeRedis, km.views.Redis), table),
+		slices.Concat(global, keymap.Bindings(keyScopeMetrics, km.views.Metrics), table),
+		slices.Concat(global, keymap.Bindings(keyScopeBatches, km.views.Batches), table),
+	} {
+		for _, conflict := range keymap.Conflicts(group) {
+			if !slices.Contains(conflicts, conflict) {
+				conflicts = append(conflicts, conflict)
+			}
+		}
+	}
+	return conflicts
+}
+
+// splitBindings separates the bindings with the given names from the rest.
+func splitBindings(bindings []keymap.Binding, names ...string) ([]keymap.Binding, []keymap.Binding) {
+	var rest, named []keymap.Binding
+	for _, b := range bindings {
+		if slices.Contains(names, b.Name) {
+			named = append(named, b)
+		} else {
+			rest = append(rest, b)
+		}
 	}
+	return rest, named
 }
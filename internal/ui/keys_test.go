@@ -0,0 +1,81 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestLoadKeyMaps_Defaults(t *testing.T) {
+	if _, err := loadKeyMaps(nil); err != nil {
+		t.Fatalf("loadKeyMaps(nil) error = %v", err)
+	}
+}
+
+func TestLoadKeyMaps_Overrides(t *testing.T) {
+	km, err := loadKeyMaps(map[string]map[string][]string{
+		"app":   {"view1": {"f1"}},
+		"table": {"line_down": {"down", "n"}},
+	})
+	if err != nil {
+		t.Fatalf("loadKeyMaps() error = %v", err)
+	}
+	if got := km.app.View1.Help().Key; got != "f1" {
+		t.Errorf("View1 help key = %q, want f1", got)
+	}
+	if got := km.views.Table.LineDown.Help().Key; got != "down/n" {
+		t.Errorf("LineDown help key = %q, want down/n", got)
+	}
+}
+
+func TestLoadKeyMaps_Errors(t *testing.T) {
+	tests := []struct {
+		name      string
+		overrides map[string]map[string][]string
+		want      string
+	}{
+		{
+			name:      "unknown-scope",
+			overrides: map[string]map[string][]string{"navbar": {"quit": {"x"}}},
+			want:      "keys.navbar: unknown scope",
+		},
+		{
+			name:      "unknown-binding",
+			overrides: map[string]map[string][]string{"table": {"jump": {"x"}}},
+			want:      `keys.table: unknown binding "jump"`,
+		},
+		{
+			name:      "global-conflict",
+			overrides: map[string]map[string][]string{"app": {"view1": {"j"}}},
+			want:      `key "j" is bound to both app.view1 and table.line_down`,
+		},
+		{
+			name:      "view-conflict",
+			overrides: map[string]map[string][]string{"queues": {"chart": {"j"}}},
+			want:      `key "j" is bound to both queues.chart and table.line_down`,
+		},
+		{
+			name:      "help-conflict",
+			overrides: map[string]map[string][]string{"help": {"line_down": {"?"}}},
+			want:      `key "?" is bound to both app.help and help.line_down`,
+		},
+		{
+			name:      "cmdline-conflict",
+			overrides: map[string]map[string][]string{"cmdline": {"next": {"tab"}}},
+			want:      `key "tab" is bound to both cmdline.next and cmdline.complete`,
+		},
+		{
+			name:      "component-conflict",
+			overrides: map[string]map[string][]string{"jobdetail": {"copy_jid": {"e"}}},
+			want:      `key "e" is bound to both jobdetail.edit and jobdetail.copy_jid`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := loadKeyMaps(tt.overrides)
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Fatalf("loadKeyMaps() error = %v, want %q", err, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,174 @@
+package theme
+
+import (
+	"errors"
+	"fmt"
+	"image/color"
+	"os"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"strings"
+
+	"charm.land/lipgloss/v2"
+	"charm.land/lipgloss/v2/compat"
+	"github.com/BurntSushi/toml"
+)
+
+// themeFileExt is the extension of theme files in the themes directory.
+const themeFileExt = ".toml"
+
+// File is the on-disk theme format.
+//
+//	base = "dark"
+//	monochrome = false
+//
+//	[colors]
+//	primary = "#F73D68"
+//	text = { light = "#111827", dark = "#F9FAFB" }
+//
+// Colors not listed are taken from the base theme.
+type File struct {
+	// Base names the built-in theme to start from (default "default").
+	Base string `toml:"base"`
+	// Monochrome overrides the base theme's monochrome setting.
+	Monochrome *bool `toml:"monochrome"`
+	// Colors maps Theme fields (e.g. "json_key") to colors.
+	Colors map[string]ColorSpec `toml:"colors"`
+}
+
+// ColorSpec is a color in a theme file: either a single value ("#FF0000",
+// "204", or "none") or a table with separate light and dark values.
+type ColorSpec struct {
+	Light string `toml:"light"`
+	Dark  string `toml:"dark"`
+}
+
+// UnmarshalTOML implements toml.Unmarshaler.
+func (c *ColorSpec) UnmarshalTOML(value any) error {
+	switch v := value.(type) {
+	case string:
+		c.Light, c.Dark = v, v
+	case map[string]any:
+		light, _ := v["light"].(string)
+		dark, _ := v["dark"].(string)
+		if light == "" || dark == "" {
+			return errors.New("color table needs both light and dark")
+		}
+		c.Light, c.Dark = light, dark
+	default:
+		return fmt.Errorf("unsupported color value %v", value)
+	}
+	return nil
+}
+
+func (c ColorSpec) color() color.Color {
+	if c.Light == c.Dark {
+		return parseColor(c.Light)
+	}
+	return compat.AdaptiveColor{Light: parseColor(c.Light), Dark: parseColor(c.Dark)}
+}
+
+func parseColor(s string) color.Color {
+	if strings.EqualFold(s, "none") {
+		return lipgloss.NoColor{}
+	}
+	return lipgloss.Color(s)
+}
+
+// Dir returns the directory searched for named theme files
+// (e.g. ~/.config/lazykiq/themes).
+func Dir() string {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(dir, "lazykiq", "themes")
+}
+
+// Names returns built-in theme names followed by themes found in Dir.
+func Names() []string {
+	names := BuiltinNames()
+	dir := Dir()
+	if dir == "" {
+		return names
+	}
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return names
+	}
+	for _, entry := range entries {
+		name, ok := strings.CutSuffix(entry.Name(), themeFileExt)
+		if !ok || entry.IsDir() || slices.Contains(names, name) {
+			continue
+		}
+		names = append(names, name)
+	}
+	return names
+}
+
+// Lookup resolves a theme by built-in name, by name of a file in Dir,
+// or by path to a theme file.
+func Lookup(name string) (Theme, error) {
+	if t, ok := Builtin(name); ok {
+		return t, nil
+	}
+	if strings.ContainsRune(name, filepath.Separator) || strings.HasSuffix(name, themeFileExt) {
+		return LoadFile(name)
+	}
+	if dir := Dir(); dir != "" {
+		path := filepath.Join(dir, name+themeFileExt)
+		if _, err := os.Stat(path); err == nil {
+			return LoadFile(path)
+		}
+	}
+	return Theme{}, fmt.Errorf("unknown theme %q (available: %s)", name, strings.Join(Names(), ", "))
+}
+
+// LoadFile reads a theme file.
+func LoadFile(path string) (Theme, error) {
+	data, err := os.ReadFile(path) //nolint:gosec // path comes from the user
+	if err != nil {
+		return Theme{}, fmt.Errorf("read theme %s: %w", path, err)
+	}
+	t, err := Parse(data)
+	if err != nil {
+		return Theme{}, fmt.Errorf("parse theme %s: %w", path, err)
+	}
+	return t, nil
+}
+
+// Parse decodes a theme from TOML data.
+func Parse(data []byte) (Theme, error) {
+	var file File
+	if err := toml.Unmarshal(data, &file); err != nil {
+		return Theme{}, err
+	}
+
+	base := file.Base
+	if base == "" {
+		base = NameDefault
+	}
+	t, ok := Builtin(base)
+	if !ok {
+		return Theme{}, fmt.Errorf("unknown base theme %q (available: %s)", base, strings.Join(builtinNames, ", "))
+	}
+	if file.Monochrome != nil {
+		t.Monochrome = *file.Monochrome
+	}
+
+	known := make(map[string]bool, len(file.Colors))
+	forEachColor(&t, func(name string, field reflect.Value) {
+		known[name] = true
+		if spec, ok := file.Colors[name]; ok {
+			field.Set(reflect.ValueOf(spec.color()))
+		}
+	})
+	for name := range file.Colors {
+		if !known[name] {
+			return Theme{}, fmt.Errorf("unknown color %q", name)
+		}
+	}
+
+	return t, nil
+}
@@ -0,0 +1,88 @@
+package theme
+
+import (
+	"reflect"
+	"testing"
+
+	"charm.land/lipgloss/v2"
+	"charm.land/lipgloss/v2/compat"
+)
+
+func TestParse(t *testing.T) {
+	theme, err := Parse([]byte(`
+base = "high-contrast"
+
+[colors]
+primary = "#112233"
+json_key = { light = "#000000", dark = "#FFFFFF" }
+border = "none"
+`))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+
+	if theme.Primary != lipgloss.Color("#112233") {
+		t.Errorf("Primary = %v, want #112233", theme.Primary)
+	}
+	want := compat.AdaptiveColor{Light: lipgloss.Color("#000000"), Dark: lipgloss.Color("#FFFFFF")}
+	if theme.JSONKey != want {
+		t.Errorf("JSONKey = %v, want %v", theme.JSONKey, want)
+	}
+	if _, ok := theme.Border.(lipgloss.NoColor); !ok {
+		t.Errorf("Border = %T, want lipgloss.NoColor", theme.Border)
+	}
+	if theme.Text != HighContrastTheme.Text {
+		t.Errorf("Text = %v, want base theme color", theme.Text)
+	}
+}
+
+func TestParse_Errors(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+	}{
+		{name: "unknown-color", data: "[colors]\nsparkle = \"#fff\"\n"},
+		{name: "unknown-base", data: "base = \"neon\"\n"},
+		{name: "half-adaptive", data: "[colors]\ntext = { light = \"#000\" }\n"},
+		{name: "invalid-toml", data: "[colors\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := Parse([]byte(tt.data)); err == nil {
+				t.Fatal("Parse() error = nil, want error")
+			}
+		})
+	}
+}
+
+func TestBuiltin(t *testing.T) {
+	for _, name := range BuiltinNames() {
+		theme, ok := Builtin(name)
+		if !ok {
+			t.Fatalf("Builtin(%q) not found", name)
+		}
+		forEachColor(&theme, func(field string, value reflect.Value) {
+			if value.IsNil() {
+				t.Errorf("%s: %s is nil", name, field)
+			}
+		})
+	}
+
+	dark, _ := Builtin(NameDark)
+	if _, ok := dark.Primary.(compat.CompleteColor); !ok {
+		t.Errorf("dark Primary = %T, want compat.CompleteColor", dark.Primary)
+	}
+}
+
+func TestDefaultName(t *testing.T) {
+	t.Setenv("NO_COLOR", "1")
+	if got := DefaultName(); got != NameMonochrome {
+		t.Fatalf("DefaultName() = %q, want %q", got, NameMonochrome)
+	}
+
+	t.Setenv("NO_COLOR", "")
+	if got := DefaultName(); got != NameDefault {
+		t.Fatalf("DefaultName() = %q, want %q", got, NameDefault)
+	}
+}
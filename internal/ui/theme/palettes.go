@@ -0,0 +1,144 @@
+package theme
+
+import (
+	"image/color"
+	"os"
+	"reflect"
+
+	"charm.land/lipgloss/v2"
+	"charm.land/lipgloss/v2/compat"
+)
+
+// Built-in theme names.
+const (
+	NameDefault      = "default"
+	NameDark         = "dark"
+	NameLight        = "light"
+	NameHighContrast = "high-contrast"
+	NameMonochrome   = "monochrome"
+)
+
+// builtinNames lists built-in themes in switcher order.
+var builtinNames = []string{NameDefault, NameDark, NameLight, NameHighContrast, NameMonochrome}
+
+// HighContrastTheme uses saturated colors on black or white backgrounds.
+var HighContrastTheme = Theme{
+	Primary:         adaptive("#000000", "#FFFF00"),
+	Text:            adaptive("#000000", "#FFFFFF"),
+	TextMuted:       adaptive("#303030", "#D0D0D0"),
+	Bg:              adaptive("#FFFFFF", "#000000"),
+	MetricsBarBg:    adaptive("#000000", "#FFFFFF"),
+	Border:          adaptive("#000000", "#FFFFFF"),
+	BorderFocus:     adaptive("#0000FF", "#FFFF00"),
+	TableSelectedFg: adaptive("#FFFFFF", "#000000"),
+	TableSelectedBg: adaptive("#0000FF", "#FFFF00"),
+	Success:         adaptive("#006400", "#00FF00"),
+	Error:           adaptive("#C00000", "#FF4040"),
+	MetricsText:     adaptive("#FFFFFF", "#000000"),
+	JSONKey:         adaptive("#0000C0", "#00FFFF"),
+	JSONString:      adaptive("#006400", "#00FF00"),
+	JSONNumber:      adaptive("#8B00A0", "#FF80FF"),
+	JSONBool:        adaptive("#C00000", "#FF4040"),
+	JSONNull:        adaptive("#303030", "#D0D0D0"),
+	JSONPunctuation: adaptive("#000000", "#FFFFFF"),
+}
+
+// MonochromeTheme uses no colors at all, relying on bold, faint, and reverse
+// text attributes. It is selected automatically when NO_COLOR is set.
+var MonochromeTheme = Theme{
+	Primary:         lipgloss.NoColor{},
+	Text:            lipgloss.NoColor{},
+	TextMuted:       lipgloss.NoColor{},
+	Bg:              lipgloss.NoColor{},
+	MetricsBarBg:    lipgloss.NoColor{},
+	Border:          lipgloss.NoColor{},
+	BorderFocus:     lipgloss.NoColor{},
+	TableSelectedFg: lipgloss.NoColor{},
+	TableSelectedBg: lipgloss.NoColor{},
+	Success:         lipgloss.NoColor{},
+	Error:           lipgloss.NoColor{},
+	MetricsText:     lipgloss.NoColor{},
+	JSONKey:         lipgloss.NoColor{},
+	JSONString:      lipgloss.NoColor{},
+	JSONNumber:      lipgloss.NoColor{},
+	JSONBool:        lipgloss.NoColor{},
+	JSONNull:        lipgloss.NoColor{},
+	JSONPunctuation: lipgloss.NoColor{},
+	Monochrome:      true,
+}
+
+// BuiltinNames returns the names of the built-in themes.
+func BuiltinNames() []string {
+	return append([]string(nil), builtinNames...)
+}
+
+// Builtin returns the built-in theme with the given name.
+func Builtin(name string) (Theme, bool) {
+	switch name {
+	case NameDefault:
+		return DefaultTheme, true
+	case NameDark:
+		return withVariant(DefaultTheme, true), true
+	case NameLight:
+		return withVariant(DefaultTheme, false), true
+	case NameHighContrast:
+		return HighContrastTheme, true
+	case NameMonochrome:
+		return MonochromeTheme, true
+	default:
+		return Theme{}, false
+	}
+}
+
+// DefaultName returns the theme used when none is configured:
+// monochrome when NO_COLOR is set, the default palette otherwise.
+func DefaultName() string {
+	if os.Getenv("NO_COLOR") != "" {
+		return NameMonochrome
+	}
+	return NameDefault
+}
+
+// adaptive builds a color with separate light and dark background values.
+func adaptive(light, dark string) compat.AdaptiveColor {
+	return compat.AdaptiveColor{Light: lipgloss.Color(light), Dark: lipgloss.Color(dark)}
+}
+
+// withVariant pins every adaptive color of t to its dark or light value,
+// ignoring the detected terminal background.
+func withVariant(t Theme, dark bool) Theme {
+	forEachColor(&t, func(_ string, field reflect.Value) {
+		field.Set(reflect.ValueOf(pinVariant(field.Interface().(color.Color), dark)))
+	})
+	return t
+}
+
+func pinVariant(c color.Color, dark bool) color.Color {
+	switch c := c.(type) {
+	case compat.AdaptiveColor:
+		if dark {
+			return c.Dark
+		}
+		return c.Light
+	case compat.CompleteAdaptiveColor:
+		if dark {
+			return c.Dark
+		}
+		return c.Light
+	default:
+		return c
+	}
+}
+
+// forEachColor calls fn with the TOML name and settable value of every color field.
+func forEachColor(t *Theme, fn func(name string, field reflect.Value)) {
+	colorType := reflect.TypeFor[color.Color]()
+	v := reflect.ValueOf(t).Elem()
+	for i := range v.NumField() {
+		field := v.Type().Field(i)
+		if field.Type != colorType {
+			continue
+		}
+		fn(field.Tag.Get("toml"), v.Field(i))
+	}
+}
@@ -2,6 +2,8 @@
 package theme
 
 import (
+	"image/color"
+
 	"charm.land/lipgloss/v2"
 	"charm.land/lipgloss/v2/compat"
 )
@@ -9,36 +11,40 @@ import (
 // Theme defines all colors used throughout the UI.
 type Theme struct {
 	// Base colors
-	Primary compat.CompleteAdaptiveColor
+	Primary color.Color `toml:"primary"`
 
 	// Text colors
-	Text      compat.CompleteAdaptiveColor
-	TextMuted compat.CompleteAdaptiveColor
+	Text      color.Color `toml:"text"`
+	TextMuted color.Color `toml:"text_muted"`
 
 	// Background colors
-	Bg           compat.AdaptiveColor
-	MetricsBarBg compat.CompleteAdaptiveColor
+	Bg           color.Color `toml:"bg"`
+	MetricsBarBg color.Color `toml:"metrics_bar_bg"`
 
 	// Border colors
-	Border      compat.AdaptiveColor
-	BorderFocus compat.CompleteAdaptiveColor
+	Border      color.Color `toml:"border"`
+	BorderFocus color.Color `toml:"border_focus"`
 
 	// Accent colors
-	TableSelectedFg compat.AdaptiveColor
-	TableSelectedBg compat.AdaptiveColor
-	Success         compat.AdaptiveColor
-	Error           compat.AdaptiveColor
+	TableSelectedFg color.Color `toml:"table_selected_fg"`
+	TableSelectedBg color.Color `toml:"table_selected_bg"`
+	Success         color.Color `toml:"success"`
+	Error           color.Color `toml:"error"`
 
 	// Metrics colors
-	MetricsText compat.CompleteAdaptiveColor
+	MetricsText color.Color `toml:"metrics_text"`
 
 	// JSON colors
-	JSONKey         compat.CompleteAdaptiveColor
-	JSONString      compat.CompleteAdaptiveColor
-	JSONNumber      compat.CompleteAdaptiveColor
-	JSONBool        compat.CompleteAdaptiveColor
-	JSONNull        compat.CompleteAdaptiveColor
-	JSONPunctuation compat.CompleteAdaptiveColor
+	JSONKey         color.Color `toml:"json_key"`
+	JSONString      color.Color `toml:"json_string"`
+	JSONNumber      color.Color `toml:"json_number"`
+	JSONBool        color.Color `toml:"json_bool"`
+	JSONNull        color.Color `toml:"json_null"`
+	JSONPunctuation color.Color `toml:"json_punctuation"`
+
+	// Monochrome renders emphasis with text attributes (bold, reverse)
+	// instead of colors.
+	Monochrome bool `toml:"monochrome"`
 }
 
 // DefaultTheme is the adaptive color scheme used by default.
@@ -179,10 +185,9 @@ type Styles struct {
 	ErrorBorder lipgloss.Style
 }
 
-// NewStyles creates a Styles instance from the default adaptive theme.
-func NewStyles() Styles {
-	t := DefaultTheme
-	return Styles{
+// NewStyles creates a Styles instance from a theme.
+func NewStyles(t Theme) Styles {
+	s := Styles{
 		// Metrics bar
 		MetricsBar: lipgloss.NewStyle().
 			Foreground(t.MetricsText).
@@ -289,4 +294,19 @@ func NewStyles() Styles {
 		ErrorBorder: lipgloss.NewStyle().
 			Foreground(t.Error),
 	}
+
+	if t.Monochrome {
+		s.TableSelected = s.TableSelected.Reverse(true)
+		s.NavKey = s.NavKey.Reverse(true)
+		s.FocusBorder = s.FocusBorder.Bold(true)
+		s.MetricsBar = s.MetricsBar.Reverse(true)
+		s.MetricsFill = s.MetricsFill.Reverse(true)
+		s.MetricsLabel = s.MetricsLabel.Reverse(true)
+		s.MetricsValue = s.MetricsValue.Reverse(true)
+		s.ViewMuted = s.ViewMuted.Faint(true)
+		s.MetricLabel = s.MetricLabel.Faint(true)
+		s.ChartFailure = s.ChartFailure.Bold(true)
+	}
+
+	return s
 }
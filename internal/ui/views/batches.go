@@ -0,0 +1,574 @@
+package views
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"charm.land/bubbles/v2/key"
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+	"github.com/kpumuk/lazykiq/internal/sidekiq"
+	"github.com/kpumuk/lazykiq/internal/ui/components/frame"
+	"github.com/kpumuk/lazykiq/internal/ui/components/messagebox"
+	"github.com/kpumuk/lazykiq/internal/ui/components/table"
+	"github.com/kpumuk/lazykiq/internal/ui/format"
+)
+
+const (
+	batchesPageSize = 25
+	// batchBarWidth is the width of the progress bar in batch details.
+	batchBarWidth = 30
+)
+
+// batchesDataMsg carries a page of batches.
+type batchesDataMsg struct {
+	batches     []sidekiq.Batch
+	currentPage int
+	totalPages  int
+	totalSize   int64
+}
+
+// batchDetailMsg carries a batch with its child jobs. err is
+// sidekiq.ErrBatchNotFound for expired batches.
+type batchDetailMsg struct {
+	bid   string
+	batch sidekiq.Batch
+	jobs  []sidekiq.BatchJob
+	err   error
+}
+
+var batchColumns = []table.Column{
+	{Title: "BID", Width: 16},
+	{Title: "Description", Width: 30},
+	{Title: "Created", Width: 19},
+	{Title: "Total", Width: 8},
+	{Title: "Pending", Width: 8},
+	{Title: "Failures", Width: 8},
+	{Title: "Progress", Width: 8},
+}
+
+var batchJobColumns = []table.Column{
+	{Title: "JID", Width: 24},
+	{Title: "Status", Width: 8},
+	{Title: "Error", Width: 60},
+}
+
+// knownBatchFields lists batch hash fields shown by name in the details;
+// any other field is listed as is.
+var knownBatchFields = []string{
+	"description", "created_at", "total", "pending", "failures", "parent", "kids", "cbq", "callbacks",
+}
+
+// Batches shows Sidekiq Pro batches and their child jobs.
+type Batches struct {
+	client      *sidekiq.Client
+	width       int
+	height      int
+	styles      Styles
+	batches     []sidekiq.Batch
+	table       table.Model
+	ready       bool
+	currentPage int
+	totalPages  int
+	totalSize   int64
+	keys        BatchesKeyMap
+
+	// Batch detail state
+	showDetail  bool
+	detailBID   string
+	detail      batchDetailMsg
+	detailReady bool
+	jobsTable   table.Model
+}
+
+// NewBatches creates a new Batches view.
+func NewBatches(client *sidekiq.Client) *Batches {
+	return &Batches{
+		client:      client,
+		currentPage: 1,
+		totalPages:  1,
+		keys:        DefaultBatchesKeyMap(),
+		table: table.New(
+			table.WithColumns(batchColumns),
+			table.WithEmptyMessage("No batches"),
+		),
+		jobsTable: table.New(
+			table.WithColumns(batchJobColumns),
+			table.WithEmptyMessage("No pending jobs"),
+		),
+	}
+}
+
+// fetchDataCmd fetches a page of batches.
+func (b *Batches) fetchDataCmd() tea.Cmd {
+	currentPage := b.currentPage
+	return ClientCmd(b.client, func() tea.Msg {
+		start := (currentPage - 1) * batchesPageSize
+		batches, totalSize, err := b.client.GetBatches(context.Background(), start, batchesPageSize)
+		if err != nil {
+			return ConnectionErrorMsg{Err: err}
+		}
+
+		totalPages := 1
+		if totalSize > 0 {
+			totalPages = int((totalSize + batchesPageSize - 1) / batchesPageSize)
+		}
+		return batchesDataMsg{
+			batches:     batches,
+			currentPage: min(max(currentPage, 1), totalPages),
+			totalPages:  totalPages,
+			totalSize:   totalSize,
+		}
+	})
+}
+
+// fetchDetailCmd fetches the shown batch.
+func (b *Batches) fetchDetailCmd() tea.Cmd {
+	bid := b.detailBID
+	return ClientCmd(b.client, func() tea.Msg {
+		batch, jobs, err := b.client.GetBatch(context.Background(), bid)
+		if err != nil && !errors.Is(err, sidekiq.ErrBatchNotFound) {
+			return ConnectionErrorMsg{Err: err}
+		}
+		return batchDetailMsg{bid: bid, batch: batch, jobs: jobs, err: err}
+	})
+}
+
+// openBatch shows the details of a batch.
+func (b *Batches) openBatch(bid string) tea.Cmd {
+	b.showDetail = true
+	b.detailBID = bid
+	b.detailReady = false
+	b.jobsTable.SetCursor(0)
+	return b.fetchDetailCmd()
+}
+
+// Init implements View.
+func (b *Batches) Init() tea.Cmd {
+	b.currentPage = 1
+	b.showDetail = false
+	return b.fetchDataCmd()
+}
+
+// Update implements View.
+func (b *Batches) Update(msg tea.Msg) (View, tea.Cmd) {
+	switch msg := msg.(type) {
+	case ShowBatchMsg:
+		return b, tea.Batch(b.openBatch(msg.BID), b.fetchDataCmd())
+
+	case batchesDataMsg:
+		b.batches = msg.batches
+		b.currentPage = msg.currentPage
+		b.totalPages = msg.totalPages
+		b.totalSize = msg.totalSize
+		b.ready = true
+		b.updateTableRows()
+		return b, nil
+
+	case batchDetailMsg:
+		if msg.bid != b.detailBID {
+			return b, nil
+		}
+		b.detail = msg
+		b.detailReady = true
+		b.updateJobsRows()
+		return b, nil
+
+	case RefreshMsg:
+		if b.showDetail {
+			return b, b.fetchDetailCmd()
+		}
+		return b, b.fetchDataCmd()
+
+	case tea.MouseWheelMsg:
+		t := b.activeTable()
+		*t, _ = t.Update(msg)
+		return b, nil
+
+	case tea.MouseClickMsg:
+		if msg.Button != tea.MouseLeft {
+			return b, nil
+		}
+		if b.showDetail {
+			b.jobsTable.ClickRow(msg.Y - b.batchInfoHeight() - frameBorderHeight)
+		} else {
+			b.table.ClickRow(msg.Y - frameBorderHeight)
+		}
+		return b, nil
+
+	case tea.KeyMsg:
+		if b.showDetail {
+			return b, b.updateDetail(msg)
+		}
+		switch {
+		case key.Matches(msg, b.keys.PrevPage):
+			if b.currentPage > 1 {
+				b.currentPage--
+				return b, b.fetchDataCmd()
+			}
+			return b, nil
+		case key.Matches(msg, b.keys.NextPage):
+			if b.currentPage < b.totalPages {
+				b.currentPage++
+				return b, b.fetchDataCmd()
+			}
+			return b, nil
+		case key.Matches(msg, b.keys.Open):
+			if idx := b.table.Cursor(); idx >= 0 && idx < len(b.batches) {
+				return b, b.openBatch(b.batches[idx].BID)
+			}
+			return b, nil
+		}
+		b.table, _ = b.table.Update(msg)
+		return b, nil
+	}
+
+	return b, nil
+}
+
+// updateDetail handles keys while a batch is shown.
+func (b *Batches) updateDetail(msg tea.KeyMsg) tea.Cmd {
+	switch {
+	case key.Matches(msg, b.keys.Back):
+		b.showDetail = false
+		return b.fetchDataCmd()
+	case key.Matches(msg, b.keys.Parent):
+		if parent := b.detail.batch.Parent; b.detailReady && parent != "" {
+			return b.openBatch(parent)
+		}
+		return nil
+	case key.Matches(msg, b.keys.Open):
+		jobs := b.detail.jobs
+		if idx := b.jobsTable.Cursor(); idx >= 0 && idx < len(jobs) {
+			jid := jobs[idx].JID
+			return func() tea.Msg {
+				return FindJobMsg{JID: jid}
+			}
+		}
+		return nil
+	}
+	b.jobsTable, _ = b.jobsTable.Update(msg)
+	return nil
+}
+
+// activeTable returns the table receiving navigation keys.
+func (b *Batches) activeTable() *table.Model {
+	if b.showDetail {
+		return &b.jobsTable
+	}
+	return &b.table
+}
+
+// View implements View.
+func (b *Batches) View() string {
+	if b.showDetail {
+		return b.renderDetail()
+	}
+	if !b.ready {
+		return b.renderMessage("Batches", "Loading...")
+	}
+	if b.totalSize == 0 {
+		return b.renderMessage("Batches", "No batches (Sidekiq Pro keeps them in the batches set)")
+	}
+	return b.renderList()
+}
+
+// Name implements View.
+func (b *Batches) Name() string {
+	return "Batches"
+}
+
+// ShortHelp implements View.
+func (b *Batches) ShortHelp() []key.Binding {
+	return nil
+}
+
+// FullHelp implements View.
+func (b *Batches) FullHelp() []HelpSection {
+	if b.showDetail {
+		return []HelpSection{
+			{
+				Title: "Batch",
+				Bindings: []key.Binding{
+					withHelpDesc(b.keys.Open, "find job in retries, scheduled, or dead"),
+					b.keys.Parent,
+					b.keys.Back,
+				},
+			},
+			tableHelp(b.jobsTable),
+		}
+	}
+	return []HelpSection{
+		{
+			Title: "Batches",
+			Bindings: []key.Binding{
+				b.keys.Open,
+				b.keys.PrevPage,
+				b.keys.NextPage,
+			},
+		},
+		tableHelp(b.table),
+	}
+}
+
+// SetSize implements View.
+func (b *Batches) SetSize(width, height int) View {
+	b.width = width
+	b.height = height
+	b.updateTableSize()
+	return b
+}
+
+// SetKeyMaps implements KeyMapConfigurable.
+func (b *Batches) SetKeyMaps(keys KeyMaps) {
+	b.keys = keys.Batches
+	b.table.KeyMap = keys.Table
+	b.jobsTable.KeyMap = keys.Table
+}
+
+// SetStyles implements View.
+func (b *Batches) SetStyles(styles Styles) View {
+	b.styles = styles
+	tableStyles := table.Styles{
+		Text:      styles.Text,
+		Muted:     styles.Muted,
+		Header:    styles.TableHeader,
+		Selected:  styles.TableSelected,
+		Separator: styles.TableSeparator,
+		Highlight: styles.ChartFailure,
+	}
+	b.table.SetStyles(tableStyles)
+	b.jobsTable.SetStyles(tableStyles)
+	return b
+}
+
+func (b *Batches) updateTableSize() {
+	b.table.SetSize(max(b.width-4, 1), max(b.height-2, 3))
+	// The batch info box sits above the jobs table
+	b.jobsTable.SetSize(max(b.width-4, 1), max(b.height-b.batchInfoHeight()-2, 3))
+}
+
+// updateTableRows fills the batch list, highlighting batches with failures.
+func (b *Batches) updateTableRows() {
+	rows := make([]table.Row, len(b.batches))
+	highlighted := make([]bool, len(b.batches))
+	for i, batch := range b.batches {
+		rows[i] = table.Row{
+			batch.BID,
+			orFallback(batch.Description, "-"),
+			batchCreatedAt(batch),
+			format.Number(batch.Total),
+			format.Number(batch.Pending),
+			format.Number(batch.Failures),
+			fmt.Sprintf("%.0f%%", batch.Progress()*100),
+		}
+		highlighted[i] = batch.Failures > 0
+	}
+	b.table.SetRows(rows)
+	b.table.SetHighlighted(highlighted)
+}
+
+// updateJobsRows fills the child jobs of the shown batch, highlighting
+// failed and dead jobs.
+func (b *Batches) updateJobsRows() {
+	jobs := b.detail.jobs
+	rows := make([]table.Row, len(jobs))
+	highlighted := make([]bool, len(jobs))
+	for i, job := range jobs {
+		message := job.ErrorMessage
+		if job.ErrorClass != "" {
+			message = job.ErrorClass + ": " + message
+		}
+		rows[i] = table.Row{job.JID, job.Status, message}
+		highlighted[i] = job.Status != sidekiq.BatchJobPending
+	}
+	b.jobsTable.SetRows(rows)
+	b.jobsTable.SetHighlighted(highlighted)
+	b.updateTableSize()
+}
+
+// batchCreatedAt formats the creation time of a batch.
+func batchCreatedAt(batch sidekiq.Batch) string {
+	if batch.CreatedAt <= 0 {
+		return "n/a"
+	}
+	return time.Unix(int64(batch.CreatedAt), 0).Format("2006-01-02 15:04:05")
+}
+
+// renderList renders the batch list.
+func (b *Batches) renderList() string {
+	sep := b.styles.Muted.Render(" • ")
+	meta := b.styles.MetricLabel.Render("SIZE: ") + b.styles.MetricValue.Render(format.Number(b.totalSize)) +
+		sep + b.styles.MetricLabel.Render("PAGE: ") + b.styles.MetricValue.Render(fmt.Sprintf("%d/%d", b.currentPage, b.totalPages))
+
+	return frame.New(
+		frame.WithStyles(b.frameStyles()),
+		frame.WithTitle("Batches"),
+		frame.WithTitlePadding(0),
+		frame.WithMeta(meta),
+		frame.WithContent(b.table.View()),
+		frame.WithPadding(1),
+		frame.WithSize(b.width, b.height),
+		frame.WithMinHeight(5),
+		frame.WithFocused(true),
+	).View()
+}
+
+// batchInfoLines describes the shown batch: progress, relations, callbacks,
+// and any hash fields not shown by name.
+func (b *Batches) batchInfoLines() []string {
+	if !b.detailReady || b.detail.err != nil {
+		return nil
+	}
+	batch := b.detail.batch
+	label := func(name string) string {
+		return b.styles.MetricLabel.Render(fmt.Sprintf("%-13s", name))
+	}
+	value := b.styles.MetricValue.Render
+
+	filled := min(int(batch.Progress()*batchBarWidth), batchBarWidth)
+	bar := b.styles.ChartSuccess.Render(strings.Repeat("█", filled)) +
+		b.styles.Muted.Render(strings.Repeat("░", batchBarWidth-filled))
+	progress := bar + "  " + value(fmt.Sprintf("%.0f%%", batch.Progress()*100)) +
+		b.styles.Muted.Render(fmt.Sprintf("  %s of %s succeeded, %s pending, %s failed",
+			format.Number(batch.Succeeded()), format.Number(batch.Total), format.Number(batch.Pending), format.Number(batch.Failures)))
+
+	created := batchCreatedAt(batch)
+	if batch.CreatedAt > 0 {
+		created += " (" + format.Duration(time.Now().Unix()-int64(batch.CreatedAt)) + " ago)"
+	}
+
+	lines := []string{
+		label("Description") + value(orFallback(batch.Description, "-")),
+		label("Created") + value(created),
+		label("Progress") + progress,
+		label("Parent") + value(orFallback(batch.Parent, "none")) +
+			b.styles.Muted.Render("  child batches: ") + value(format.Number(batch.Kids)),
+	}
+	if batch.CallbackQueue != "" {
+		lines = append(lines, label("Callbacks")+b.styles.Muted.Render("queue ")+value(batch.CallbackQueue))
+	}
+	for _, callback := range batch.Callbacks {
+		line := value(callback.Event) + b.styles.Muted.Render(": ") + value(callback.Class)
+		if callback.Options != nil {
+			if options, err := json.Marshal(callback.Options); err == nil {
+				line += " " + b.styles.Muted.Render(string(options))
+			}
+		}
+		lines = append(lines, label("")+line)
+	}
+
+	extra := make([]string, 0, len(batch.Fields))
+	for name := range batch.Fields {
+		if !slices.Contains(knownBatchFields, name) {
+			extra = append(extra, name)
+		}
+	}
+	slices.Sort(extra)
+	for _, name := range extra {
+		lines = append(lines, label(name)+value(batch.Fields[name]))
+	}
+	return lines
+}
+
+// shownBatchInfoLines returns the batch info lines fitting in about half
+// of the view, ending with a "+N more" line when some are cut.
+func (b *Batches) shownBatchInfoLines() []string {
+	lines := b.batchInfoLines()
+	// Frame borders take two lines of the half
+	limit := max(b.height/2-2, 2)
+	if len(lines) <= limit {
+		return lines
+	}
+	hidden := len(lines) - limit + 1
+	return append(lines[:limit-1:limit-1], b.styles.Muted.Render(fmt.Sprintf("+%d more", hidden)))
+}
+
+// batchInfoHeight returns the height of the batch info box, or zero when
+// no batch is shown.
+func (b *Batches) batchInfoHeight() int {
+	if !b.showDetail {
+		return 0
+	}
+	lines := b.shownBatchInfoLines()
+	if len(lines) == 0 {
+		return 0
+	}
+	// Frame borders around the lines
+	return len(lines) + 2
+}
+
+// renderDetail renders the batch info above its child jobs.
+func (b *Batches) renderDetail() string {
+	title := "Batch " + b.detailBID
+	switch {
+	case !b.detailReady:
+		return b.renderMessage(title, "Loading...")
+	case errors.Is(b.detail.err, sidekiq.ErrBatchNotFound):
+		return b.renderMessage(title, "Batch not found, it may have expired")
+	}
+
+	sep := b.styles.Muted.Render(" • ")
+	counts := make(map[string]int)
+	for _, job := range b.detail.jobs {
+		counts[job.Status]++
+	}
+	infoMeta := b.styles.MetricLabel.Render("total: ") + b.styles.MetricValue.Render(format.Number(b.detail.batch.Total))
+	jobsMeta := b.styles.MetricLabel.Render("pending: ") + b.styles.MetricValue.Render(format.Number(int64(counts[sidekiq.BatchJobPending]))) +
+		sep + b.styles.MetricLabel.Render("failed: ") + b.styles.MetricValue.Render(format.Number(int64(counts[sidekiq.BatchJobFailed]))) +
+		sep + b.styles.MetricLabel.Render("dead: ") + b.styles.MetricValue.Render(format.Number(int64(counts[sidekiq.BatchJobDead])))
+
+	infoHeight := b.batchInfoHeight()
+	info := frame.New(
+		frame.WithStyles(b.frameStyles()),
+		frame.WithTitle(title),
+		frame.WithTitlePadding(0),
+		frame.WithMeta(infoMeta),
+		frame.WithContent(lipgloss.NewStyle().MaxWidth(max(b.width-4, 1)).Render(strings.Join(b.shownBatchInfoLines(), "\n"))),
+		frame.WithPadding(1),
+		frame.WithSize(b.width, infoHeight),
+		frame.WithFocused(false),
+	).View()
+
+	jobs := frame.New(
+		frame.WithStyles(b.frameStyles()),
+		frame.WithTitle("Jobs"),
+		frame.WithTitlePadding(0),
+		frame.WithMeta(jobsMeta),
+		frame.WithContent(b.jobsTable.View()),
+		frame.WithPadding(1),
+		frame.WithSize(b.width, b.height-infoHeight),
+		frame.WithMinHeight(5),
+		frame.WithFocused(true),
+	).View()
+
+	return lipgloss.JoinVertical(lipgloss.Left, info, jobs)
+}
+
+// renderMessage renders a message box in place of the view content.
+func (b *Batches) renderMessage(title, msg string) string {
+	return messagebox.Render(messagebox.Styles{
+		Title:  b.styles.Title,
+		Muted:  b.styles.Muted,
+		Border: b.styles.FocusBorder,
+	}, title, msg, b.width, b.height)
+}
+
+// frameStyles returns the styles of the view frames.
+func (b *Batches) frameStyles() frame.Styles {
+	return frame.Styles{
+		Focused: frame.StyleState{
+			Title:  b.styles.Title,
+			Border: b.styles.FocusBorder,
+		},
+		Blurred: frame.StyleState{
+			Title:  b.styles.Title,
+			Border: b.styles.BorderStyle,
+		},
+	}
+}
@@ -1,15 +1,20 @@
 package views
 
 import (
+	"cmp"
 	"context"
+	"encoding/json"
 	"fmt"
+	"slices"
 	"strings"
 	"time"
 
 	"charm.land/bubbles/v2/key"
 	tea "charm.land/bubbletea/v2"
 	"charm.land/lipgloss/v2"
+	"github.com/kpumuk/lazykiq/internal/config"
 	"github.com/kpumuk/lazykiq/internal/sidekiq"
+	"github.com/kpumuk/lazykiq/internal/ui/clipboard"
 	"github.com/kpumuk/lazykiq/internal/ui/components/frame"
 	"github.com/kpumuk/lazykiq/internal/ui/components/jobdetail"
 	"github.com/kpumuk/lazykiq/internal/ui/components/messagebox"
@@ -22,6 +27,33 @@ type busyDataMsg struct {
 	data sidekiq.BusyData
 }
 
+// busyCleanupMsg reports how many stale processes were removed.
+type busyCleanupMsg struct {
+	removed int64
+	err     error
+}
+
+// RuntimeThresholds configures when running jobs count as long-running.
+type RuntimeThresholds struct {
+	// Default applies to classes without their own threshold; zero
+	// disables highlighting.
+	Default time.Duration
+	// PerClass overrides Default by job class.
+	PerClass map[string]time.Duration
+}
+
+// For returns the threshold for a job, looking up its display class first
+// and then the class Sidekiq executes (e.g. an ActiveJob wrapper).
+func (t RuntimeThresholds) For(job sidekiq.Job) time.Duration {
+	if threshold, ok := t.PerClass[job.DisplayClass()]; ok {
+		return threshold
+	}
+	if threshold, ok := t.PerClass[job.Klass()]; ok {
+		return threshold
+	}
+	return t.Default
+}
+
 // Busy shows active workers/processes.
 type Busy struct {
 	client          *sidekiq.Client
@@ -33,6 +65,14 @@ type Busy struct {
 	table           table.Model
 	ready           bool
 	selectedProcess int // -1 = all, 0-8 = specific process index
+	columns         columnSet[sidekiq.Job]
+	showProcess     bool // show details of the selected process above its jobs
+	status          string
+	thresholds      RuntimeThresholds
+	sortByElapsed   bool // longest running jobs first instead of by process
+	showSummary     bool // group running jobs by class and queue
+	summaryTable    table.Model
+	keys            BusyKeyMap
 
 	// Job detail state
 	showDetail bool
@@ -41,11 +81,18 @@ type Busy struct {
 
 // NewBusy creates a new Busy view.
 func NewBusy(client *sidekiq.Client) *Busy {
+	columns := newColumnSet(busyJobColumns, busyJobRecord, busyDefaultColumns)
 	return &Busy{
 		client:          client,
 		selectedProcess: -1, // Show all jobs by default
+		columns:         columns,
+		keys:            DefaultBusyKeyMap(),
 		table: table.New(
-			table.WithColumns(jobColumns),
+			table.WithColumns(columns.tableColumns()),
+			table.WithEmptyMessage("No active jobs"),
+		),
+		summaryTable: table.New(
+			table.WithColumns(busySummaryColumns),
 			table.WithEmptyMessage("No active jobs"),
 		),
 		jobDetail: jobdetail.New(),
@@ -54,19 +101,39 @@ func NewBusy(client *sidekiq.Client) *Busy {
 
 // fetchDataCmd fetches busy data from Redis.
 func (b *Busy) fetchDataCmd() tea.Cmd {
-	return func() tea.Msg {
+	return ClientCmd(b.client, func() tea.Msg {
 		ctx := context.Background()
 		data, err := b.client.GetBusyData(ctx)
 		if err != nil {
 			return ConnectionErrorMsg{Err: err}
 		}
 		return busyDataMsg{data: data}
+	})
+}
+
+// cleanupCmd removes stale processes from the processes set.
+func (b *Busy) cleanupCmd() tea.Cmd {
+	return ClientCmd(b.client, func() tea.Msg {
+		removed, err := b.client.CleanupProcesses(context.Background())
+		return busyCleanupMsg{removed: removed, err: err}
+	})
+}
+
+// staleCount returns the number of processes that missed their heartbeats.
+func (b *Busy) staleCount() int {
+	count := 0
+	for _, proc := range b.data.Processes {
+		if proc.Stale(b.data.Now) {
+			count++
+		}
 	}
+	return count
 }
 
 // Init implements View.
 func (b *Busy) Init() tea.Cmd {
 	b.showDetail = false
+	b.status = ""
 	return b.fetchDataCmd()
 }
 
@@ -76,13 +143,14 @@ func (b *Busy) Update(msg tea.Msg) (View, tea.Cmd) {
 	if b.showDetail {
 		switch msg := msg.(type) {
 		case tea.KeyMsg:
-			if msg.String() == "esc" {
+			if key.Matches(msg, b.jobDetail.KeyMap.Close) {
 				b.showDetail = false
 				return b, nil
 			}
 		}
-		b.jobDetail, _ = b.jobDetail.Update(msg)
-		return b, nil
+		var cmd tea.Cmd
+		b.jobDetail, cmd = b.jobDetail.Update(msg)
+		return b, cmd
 	}
 
 	switch msg := msg.(type) {
@@ -92,25 +160,107 @@ func (b *Busy) Update(msg tea.Msg) (View, tea.Cmd) {
 		b.updateTableRows()
 		return b, nil
 
+	case busyCleanupMsg:
+		if msg.err != nil {
+			b.status = "Cleanup failed: " + msg.err.Error()
+			return b, nil
+		}
+		b.status = fmt.Sprintf("Removed %d stale processes", msg.removed)
+		if msg.removed == 1 {
+			b.status = "Removed 1 stale process"
+		}
+		b.selectedProcess = -1
+		b.showProcess = false
+		return b, b.fetchDataCmd()
+
 	case RefreshMsg:
 		return b, b.fetchDataCmd()
 
+	case RenderTickMsg:
+		// Elapsed times and highlights are computed when building rows
+		b.updateTableRows()
+		return b, nil
+
+	case tea.MouseWheelMsg:
+		if b.showSummary {
+			b.summaryTable, _ = b.summaryTable.Update(msg)
+		} else {
+			b.table, _ = b.table.Update(msg)
+		}
+		return b, nil
+
+	case tea.MouseClickMsg:
+		if msg.Button != tea.MouseLeft {
+			return b, nil
+		}
+		// Process list lines come first, then the framed jobs table.
+		// Clicking the selected process shows jobs from all processes again.
+		if msg.Y < len(b.data.Processes) {
+			if b.selectedProcess == msg.Y {
+				b.selectedProcess = -1
+			} else {
+				b.selectedProcess = msg.Y
+			}
+			b.updateTableRows()
+			return b, nil
+		}
+		y := msg.Y - len(b.data.Processes) - b.processInfoHeight() - frameBorderHeight
+		if b.showSummary {
+			b.summaryTable.ClickRow(y - b.summaryHeaderHeight())
+		} else {
+			b.table.ClickRow(y)
+		}
+		return b, nil
+
 	case tea.KeyMsg:
-		switch msg.String() {
-		case "ctrl+0":
+		switch {
+		case key.Matches(msg, b.keys.AllProcesses):
 			if b.selectedProcess != -1 {
 				b.selectedProcess = -1
+				b.showProcess = false
 				b.updateTableRows()
 			}
 			return b, nil
-		case "ctrl+1", "ctrl+2", "ctrl+3", "ctrl+4", "ctrl+5", "ctrl+6", "ctrl+7", "ctrl+8", "ctrl+9":
-			idx := int(msg.String()[5] - '1')
+		case key.Matches(msg, b.keys.ProcessInfo):
+			// Show details of the selected process, or of the process
+			// running the job under the cursor
+			if b.showProcess {
+				b.showProcess = false
+			} else if b.selectProcessForInfo() {
+				b.showProcess = true
+			}
+			b.updateTableRows()
+			return b, nil
+		case key.Matches(msg, b.keys.Summary):
+			b.showSummary = !b.showSummary
+			b.updateTableRows()
+			return b, nil
+		case key.Matches(msg, b.keys.SortByElapsed):
+			b.sortByElapsed = !b.sortByElapsed
+			b.updateTableRows()
+			return b, nil
+		case key.Matches(msg, b.keys.RemoveStale):
+			if b.staleCount() > 0 {
+				return b, b.cleanupCmd()
+			}
+			return b, nil
+		case key.Matches(msg, b.keys.CloseInfo):
+			if b.showProcess {
+				b.showProcess = false
+				b.updateTableSize()
+			}
+			return b, nil
+		case key.Matches(msg, b.keys.SelectProcess):
+			idx := matchedIndex(b.keys.SelectProcess, msg.String())
 			if idx >= 0 && idx < len(b.data.Processes) && b.selectedProcess != idx {
 				b.selectedProcess = idx
 				b.updateTableRows()
 			}
 			return b, nil
-		case "enter":
+		case key.Matches(msg, b.keys.Open):
+			if b.showSummary {
+				return b, nil
+			}
 			// Show detail for selected job
 			if idx := b.table.Cursor(); idx >= 0 && idx < len(b.filteredJobs) {
 				b.jobDetail.SetJob(b.filteredJobs[idx].JobRecord)
@@ -119,7 +269,11 @@ func (b *Busy) Update(msg tea.Msg) (View, tea.Cmd) {
 			return b, nil
 		}
 
-		b.table, _ = b.table.Update(msg)
+		if b.showSummary {
+			b.summaryTable, _ = b.summaryTable.Update(msg)
+		} else {
+			b.table, _ = b.table.Update(msg)
+		}
 		return b, nil
 	}
 
@@ -140,7 +294,15 @@ func (b *Busy) View() string {
 		return b.renderMessage("No active processes")
 	}
 
-	boxContent := b.renderJobsBox()
+	var boxContent string
+	if b.showSummary {
+		boxContent = b.renderSummaryBox()
+	} else {
+		boxContent = b.renderJobsBox()
+	}
+	if b.processInfoHeight() > 0 {
+		boxContent = lipgloss.JoinVertical(lipgloss.Left, b.renderProcessInfo(), boxContent)
+	}
 
 	if len(b.data.Processes) > 0 {
 		processList := b.renderProcessList()
@@ -160,6 +322,33 @@ func (b *Busy) ShortHelp() []key.Binding {
 	return nil
 }
 
+// FullHelp implements View.
+func (b *Busy) FullHelp() []HelpSection {
+	if b.showDetail {
+		return jobDetailHelp(b.jobDetail)
+	}
+	activeTable := b.table
+	if b.showSummary {
+		activeTable = b.summaryTable
+	}
+	bindings := []key.Binding{
+		b.keys.Open,
+		b.keys.Summary,
+		b.keys.SelectProcess,
+		b.keys.AllProcesses,
+		b.keys.ProcessInfo,
+		b.keys.RemoveStale,
+		b.keys.SortByElapsed,
+	}
+	if b.showProcess {
+		bindings = append(bindings, b.keys.CloseInfo)
+	}
+	return []HelpSection{
+		{Title: "Busy", Bindings: bindings},
+		tableHelp(activeTable),
+	}
+}
+
 // SetSize implements View.
 func (b *Busy) SetSize(width, height int) View {
 	b.width = width
@@ -170,6 +359,35 @@ func (b *Busy) SetSize(width, height int) View {
 	return b
 }
 
+// SetColumns implements ColumnConfigurable.
+func (b *Busy) SetColumns(columns []config.Column) error {
+	if err := b.columns.configure(columns); err != nil {
+		return err
+	}
+	b.table.SetColumns(b.columns.tableColumns())
+	b.updateTableRows()
+	return nil
+}
+
+// SetRuntimeThresholds implements ThresholdConfigurable.
+func (b *Busy) SetRuntimeThresholds(thresholds RuntimeThresholds) {
+	b.thresholds = thresholds
+	b.updateTableRows()
+}
+
+// SetClipboardMode implements ClipboardConfigurable.
+func (b *Busy) SetClipboardMode(mode clipboard.Mode) {
+	b.jobDetail.SetClipboardMode(mode)
+}
+
+// SetKeyMaps implements KeyMapConfigurable.
+func (b *Busy) SetKeyMaps(keys KeyMaps) {
+	b.keys = keys.Busy
+	b.table.KeyMap = keys.Table
+	b.summaryTable.KeyMap = keys.Table
+	b.jobDetail.KeyMap = keys.JobDetail
+}
+
 // SetStyles implements View.
 func (b *Busy) SetStyles(styles Styles) View {
 	b.styles = styles
@@ -179,6 +397,14 @@ func (b *Busy) SetStyles(styles Styles) View {
 		Header:    styles.TableHeader,
 		Selected:  styles.TableSelected,
 		Separator: styles.TableSeparator,
+		Highlight: styles.ChartFailure,
+	})
+	b.summaryTable.SetStyles(table.Styles{
+		Text:      styles.Text,
+		Muted:     styles.Muted,
+		Header:    styles.TableHeader,
+		Selected:  styles.TableSelected,
+		Separator: styles.TableSeparator,
 	})
 	b.jobDetail.SetStyles(jobdetail.Styles{
 		Title:           styles.Title,
@@ -195,6 +421,7 @@ func (b *Busy) SetStyles(styles Styles) View {
 		PanelTitle:      styles.Title,
 		FocusBorder:     styles.FocusBorder,
 		Muted:           styles.Muted,
+		Selected:        styles.TableSelected,
 	})
 	return b
 }
@@ -217,8 +444,10 @@ func (b *Busy) renderProcessList() string {
 		started string
 		rss     string
 		queues  string
+		stale   string
 	}
 	rows := make([]processRow, len(b.data.Processes))
+	now := b.data.Now
 
 	for i, proc := range b.data.Processes {
 		// Name: hostname:pid + tag
@@ -239,7 +468,16 @@ func (b *Busy) renderProcessList() string {
 		// Queues
 		queues := strings.Join(proc.Queues, ", ")
 
-		rows[i] = processRow{name, busy, started, rss, queues}
+		// Stale: heartbeat missing or too old
+		var stale string
+		if proc.Stale(now) {
+			stale = "stale"
+			if proc.Beat > 0 {
+				stale += ", beat " + format.Duration(now.Unix()-int64(proc.Beat)) + " ago"
+			}
+		}
+
+		rows[i] = processRow{name, busy, started, rss, queues, stale}
 
 		if len(name) > maxNameLen {
 			maxNameLen = len(name)
@@ -278,31 +516,59 @@ func (b *Busy) renderProcessList() string {
 		// Queues (muted)
 		queues := b.styles.Muted.Render("  " + row.queues)
 
-		lines = append(lines, hotkey+name+stats+queues)
+		line := hotkey + name + stats + queues
+		if row.stale != "" {
+			line += b.styles.ChartFailure.Bold(true).Render("  " + row.stale)
+		}
+		lines = append(lines, line)
 	}
 
 	return b.styles.BoxPadding.Render(strings.Join(lines, "\n"))
 }
 
-// Table columns for job list.
-var jobColumns = []table.Column{
-	{Title: "Process", Width: 18},
-	{Title: "TID", Width: 6},
-	{Title: "JID", Width: 24},
-	{Title: "Queue", Width: 12},
-	{Title: "Age", Width: 6},
-	{Title: "Class", Width: 30},
-	{Title: "Args", Width: 60},
+// Table columns for job list, in addition to the shared job record columns.
+var busyJobColumns = []jobColumn[sidekiq.Job]{
+	{name: "process", title: "Process", width: 18, value: func(job sidekiq.Job) string {
+		processID := job.ProcessIdentity
+		parts := strings.Split(processID, ":")
+		if len(parts) >= 2 {
+			processID = parts[0] + ":" + parts[1]
+		}
+		return processID
+	}},
+	{name: "tid", title: "TID", width: 6, value: func(job sidekiq.Job) string {
+		return job.ThreadID
+	}},
+	{name: "queue", title: "Queue", width: 12, value: func(job sidekiq.Job) string {
+		return job.Queue()
+	}},
+	{name: "age", title: "Age", width: 6, value: func(job sidekiq.Job) string {
+		return format.Duration(time.Now().Unix() - job.RunAt)
+	}},
+	{name: "class", title: "Class", width: 30, value: func(job sidekiq.Job) string {
+		return job.DisplayClass()
+	}},
+	{name: "args", title: "Args", width: 60, value: func(job sidekiq.Job) string {
+		return format.Args(job.DisplayArgs())
+	}},
+}
+
+// busyDefaultColumns lists the columns shown unless overridden in the config file.
+var busyDefaultColumns = []string{"process", "tid", "jid", "queue", "age", "class", "args"}
+
+func busyJobRecord(job sidekiq.Job) *sidekiq.JobRecord {
+	return job.JobRecord
 }
 
 // updateTableSize updates the table dimensions based on current view size.
 func (b *Busy) updateTableSize() {
 	// Calculate table height: total height - process list - box borders
-	processListHeight := len(b.data.Processes)
+	processListHeight := len(b.data.Processes) + b.processInfoHeight()
 	tableHeight := max(b.height-processListHeight-2, 3)
 	// Table width: view width - box borders - padding
 	tableWidth := b.width - 4
 	b.table.SetSize(tableWidth, tableHeight)
+	b.summaryTable.SetSize(tableWidth, max(tableHeight-b.summaryHeaderHeight(), 3))
 }
 
 // updateTableRows converts job data to table rows.
@@ -323,28 +589,60 @@ func (b *Busy) updateTableRows() {
 		}
 
 		b.filteredJobs = append(b.filteredJobs, job)
+	}
 
-		processID := job.ProcessIdentity
-		parts := strings.Split(processID, ":")
-		if len(parts) >= 2 {
-			processID = parts[0] + ":" + parts[1]
+	// Jobs come from hashes in no particular order: keep them grouped by
+	// process, or put the longest running first
+	slices.SortStableFunc(b.filteredJobs, func(x, y sidekiq.Job) int {
+		if b.sortByElapsed {
+			if c := cmp.Compare(x.RunAt, y.RunAt); c != 0 {
+				return c
+			}
 		}
-
-		row := table.Row{
-			processID,
-			job.ThreadID,
-			job.JID(),
-			job.Queue(),
-			format.Duration(time.Now().Unix() - job.RunAt),
-			job.DisplayClass(),
-			format.Args(job.DisplayArgs()),
+		if c := cmp.Compare(x.ProcessIdentity, y.ProcessIdentity); c != 0 {
+			return c
 		}
-		rows = append(rows, row)
+		return cmp.Compare(x.ThreadID, y.ThreadID)
+	})
+
+	now := time.Now()
+	highlighted := make([]bool, len(b.filteredJobs))
+	for i, job := range b.filteredJobs {
+		rows = append(rows, b.columns.row(job))
+		highlighted[i] = b.overThreshold(job, now)
 	}
 	b.table.SetRows(rows)
+	b.table.SetHighlighted(highlighted)
+	b.updateSummaryRows()
 	b.updateTableSize()
 }
 
+// overThreshold reports whether a job has been running longer than the
+// threshold for its class.
+func (b *Busy) overThreshold(job sidekiq.Job, now time.Time) bool {
+	if job.JobRecord == nil {
+		return false
+	}
+	threshold := b.thresholds.For(job)
+	if threshold <= 0 {
+		return false
+	}
+	return now.Sub(time.Unix(job.RunAt, 0)) > threshold
+}
+
+// overThresholdCount returns the number of listed jobs running longer than
+// their threshold.
+func (b *Busy) overThresholdCount() int {
+	now := time.Now()
+	count := 0
+	for _, job := range b.filteredJobs {
+		if b.overThreshold(job, now) {
+			count++
+		}
+	}
+	return count
+}
+
 // renderJobsBox renders the bordered box containing the jobs table.
 func (b *Busy) renderJobsBox() string {
 	// Calculate stats for meta
@@ -369,9 +667,15 @@ func (b *Busy) renderJobsBox() string {
 	meta := b.styles.MetricLabel.Render("PRC: ") + b.styles.MetricValue.Render(fmt.Sprintf("%d", processCount)) +
 		sep + b.styles.MetricLabel.Render("THR: ") + b.styles.MetricValue.Render(fmt.Sprintf("%d/%d (%d%%)", busyThreads, totalThreads, percentage)) +
 		sep + b.styles.MetricLabel.Render("RSS: ") + b.styles.MetricValue.Render(format.Bytes(totalRSS))
+	if stale := b.staleCount(); stale > 0 {
+		meta += sep + b.styles.MetricLabel.Render("STALE: ") + b.styles.ChartFailure.Bold(true).Render(fmt.Sprintf("%d", stale))
+	}
+	if b.status != "" {
+		meta = b.styles.Muted.Render(b.status) + sep + meta
+	}
 
 	// Calculate box height (account for process list above)
-	processListHeight := len(b.data.Processes)
+	processListHeight := len(b.data.Processes) + b.processInfoHeight()
 	boxHeight := b.height - processListHeight
 
 	// Build title based on selected process
@@ -380,6 +684,12 @@ func (b *Busy) renderJobsBox() string {
 		proc := b.data.Processes[b.selectedProcess]
 		title = fmt.Sprintf("Active Jobs on %s:%s", proc.Hostname, proc.PID)
 	}
+	if b.sortByElapsed {
+		title += " by Elapsed"
+	}
+	if over := b.overThresholdCount(); over > 0 {
+		title += fmt.Sprintf(" (%d over threshold)", over)
+	}
 
 	// Get table content
 	content := b.table.View()
@@ -407,6 +717,175 @@ func (b *Busy) renderJobsBox() string {
 	return box.View()
 }
 
+// knownProcessInfo lists info fields shown by name in the process details;
+// any other field is listed as is.
+var knownProcessInfo = map[string]bool{
+	"hostname": true, "pid": true, "identity": true, "tag": true, "concurrency": true,
+	"queues": true, "weights": true, "labels": true, "version": true, "started_at": true,
+	"embedded": true, "capsules": true,
+}
+
+// selectedProcessInfo returns the selected process, or nil.
+func (b *Busy) selectedProcessInfo() *sidekiq.Process {
+	if b.selectedProcess < 0 || b.selectedProcess >= len(b.data.Processes) {
+		return nil
+	}
+	return &b.data.Processes[b.selectedProcess]
+}
+
+// selectProcessForInfo selects the process running the job under the cursor
+// when no process is selected. It reports whether a process is selected.
+func (b *Busy) selectProcessForInfo() bool {
+	if b.selectedProcessInfo() != nil {
+		return true
+	}
+	idx := b.table.Cursor()
+	if idx < 0 || idx >= len(b.filteredJobs) {
+		return false
+	}
+	for i, proc := range b.data.Processes {
+		if proc.Identity == b.filteredJobs[idx].ProcessIdentity {
+			b.selectedProcess = i
+			return true
+		}
+	}
+	return false
+}
+
+// processInfoHeight returns the height of the process details box, or 0
+// when it is hidden.
+func (b *Busy) processInfoHeight() int {
+	if !b.showProcess || b.selectedProcessInfo() == nil {
+		return 0
+	}
+	// Content lines plus the frame borders
+	return len(b.processInfoLines(max(b.width-4, 1))) + 2
+}
+
+// processInfoFields lists the heartbeat and info fields of a process.
+func processInfoFields(proc sidekiq.Process, now time.Time) [][2]string {
+	yesNo := func(v bool) string {
+		if v {
+			return "yes"
+		}
+		return "no"
+	}
+	beat := "n/a"
+	if proc.Beat > 0 {
+		beat = format.Duration(now.Unix()-int64(proc.Beat)) + " ago"
+	}
+	started := "n/a"
+	if proc.StartedAt > 0 {
+		started = time.Unix(proc.StartedAt, 0).Format("2006-01-02 15:04:05") +
+			" (" + format.Duration(now.Unix()-proc.StartedAt) + " ago)"
+	}
+
+	fields := [][2]string{
+		{"Version", orFallback(proc.Version, "n/a")},
+		{"Tag", orFallback(proc.Tag, "n/a")},
+		{"Started", started},
+		{"Beat", beat},
+		{"Busy", fmt.Sprintf("%d/%d", proc.Busy, proc.Concurrency)},
+		{"RSS", format.Bytes(proc.RSS)},
+		{"RTT", formatRedisDuration(time.Duration(proc.RTT) * time.Microsecond)},
+		{"Quiet", yesNo(proc.Quiet)},
+		{"Embedded", yesNo(proc.Embedded)},
+		{"Labels", orFallback(strings.Join(proc.Labels, ", "), "none")},
+		{"Queues", strings.Join(proc.Queues, ", ")},
+	}
+
+	// Each capsule has its own threads and queue weights
+	for _, capsule := range proc.Capsules {
+		queues := make([]string, len(capsule.Queues))
+		for i, queue := range capsule.Queues {
+			queues[i] = queue
+			if capsule.Mode == sidekiq.CapsuleWeighted {
+				queues[i] = fmt.Sprintf("%s=%d", queue, capsule.Weights[queue])
+			}
+		}
+		// Sidekiq 7 only reports the threads of the whole process
+		threads := "? threads"
+		if capsule.Concurrency > 0 {
+			threads = fmt.Sprintf("%d threads", capsule.Concurrency)
+		}
+		fields = append(fields, [2]string{
+			"Capsule " + capsule.Name,
+			fmt.Sprintf("%s, %s: %s", threads, capsule.Mode, strings.Join(queues, " ")),
+		})
+	}
+
+	extra := make([]string, 0, len(proc.Info))
+	for name := range proc.Info {
+		if !knownProcessInfo[name] {
+			extra = append(extra, name)
+		}
+	}
+	slices.Sort(extra)
+	for _, name := range extra {
+		value, err := json.Marshal(proc.Info[name])
+		if err != nil {
+			continue
+		}
+		fields = append(fields, [2]string{name, string(value)})
+	}
+	return fields
+}
+
+// processInfoLines lays process fields out as label/value pairs, wrapping
+// to the given width.
+func (b *Busy) processInfoLines(width int) []string {
+	proc := b.selectedProcessInfo()
+	if proc == nil {
+		return nil
+	}
+
+	const gap = "   "
+	var lines []string
+	line := ""
+	for _, field := range processInfoFields(*proc, b.data.Now) {
+		item := b.styles.MetricLabel.Render(field[0]+": ") + b.styles.MetricValue.Render(field[1])
+		if line != "" && lipgloss.Width(line)+len(gap)+lipgloss.Width(item) > width {
+			lines = append(lines, line)
+			line = ""
+		}
+		if line != "" {
+			line += gap
+		}
+		line += item
+	}
+	if line != "" {
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+// renderProcessInfo renders the details of the selected process.
+func (b *Busy) renderProcessInfo() string {
+	proc := b.selectedProcessInfo()
+	lines := b.processInfoLines(max(b.width-4, 1))
+	meta := b.styles.MetricLabel.Render("identity: ") + b.styles.MetricValue.Render(proc.Identity)
+
+	return frame.New(
+		frame.WithStyles(frame.Styles{
+			Focused: frame.StyleState{
+				Title:  b.styles.Title,
+				Border: b.styles.FocusBorder,
+			},
+			Blurred: frame.StyleState{
+				Title:  b.styles.Title,
+				Border: b.styles.BorderStyle,
+			},
+		}),
+		frame.WithTitle(fmt.Sprintf("Process %s:%s", proc.Hostname, proc.PID)),
+		frame.WithTitlePadding(0),
+		frame.WithMeta(meta),
+		frame.WithContent(lipgloss.NewStyle().MaxWidth(max(b.width-4, 1)).Render(strings.Join(lines, "\n"))),
+		frame.WithPadding(1),
+		frame.WithSize(b.width, len(lines)+2),
+		frame.WithFocused(false),
+	).View()
+}
+
 func (b *Busy) renderMessage(msg string) string {
 	// Header: "No processes" placeholder
 	header := b.styles.BoxPadding.Render(b.styles.Muted.Render("No processes"))
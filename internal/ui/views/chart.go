@@ -0,0 +1,81 @@
+package views
+
+import (
+	"time"
+
+	tslc "github.com/NimbleMarkets/ntcharts/linechart/timeserieslinechart"
+	oldgloss "github.com/charmbracelet/lipgloss"
+)
+
+// chartSeries is a named data set drawn by renderLineChart. The first
+// series is the chart's default data set.
+type chartSeries struct {
+	name   string
+	values []float64
+	style  oldgloss.Style
+}
+
+// renderLineChart draws time series sharing one Y axis from zero to the
+// largest value. Series are aligned to the latest times.
+func renderLineChart(styles Styles, width, height int, times []time.Time, series []chartSeries, xFormatter, yFormatter func(int, float64) string) string {
+	if width < 1 || height < 1 {
+		return ""
+	}
+	n := len(times)
+	for _, s := range series {
+		n = min(n, len(s.values))
+	}
+	if n == 0 || len(series) == 0 {
+		return renderCenteredLoading(width, height)
+	}
+	times = times[len(times)-n:]
+
+	minTime := times[0]
+	maxTime := times[len(times)-1]
+	if !maxTime.After(minTime) {
+		maxTime = minTime.Add(time.Second)
+	}
+
+	maxVal := 1.0
+	for _, s := range series {
+		for _, v := range s.values[len(s.values)-n:] {
+			maxVal = max(maxVal, v)
+		}
+	}
+
+	// TODO: Switch to new lipgloss styles after ntcharts switches to lipgloss v2
+	muted := oldStyle(styles.Muted)
+
+	chart := tslc.New(width, height,
+		tslc.WithXYSteps(2, 2),
+		tslc.WithXLabelFormatter(xFormatter),
+		tslc.WithYLabelFormatter(yFormatter),
+		tslc.WithAxesStyles(muted, muted),
+		tslc.WithTimeRange(minTime, maxTime),
+		tslc.WithYRange(0, maxVal),
+	)
+	chart.AutoMinX = false
+	chart.AutoMaxX = false
+	chart.AutoMinY = false
+	chart.AutoMaxY = false
+
+	for i, s := range series {
+		values := s.values[len(s.values)-n:]
+		if i == 0 {
+			chart.SetStyle(s.style)
+		} else {
+			chart.SetDataSetStyle(s.name, s.style)
+		}
+		for j, v := range values {
+			point := tslc.TimePoint{Time: times[j], Value: v}
+			if i == 0 {
+				chart.Push(point)
+			} else {
+				chart.PushDataSet(s.name, point)
+			}
+		}
+	}
+
+	chart.DrawBrailleAll()
+	return chart.View()
+}
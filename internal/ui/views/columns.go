@@ -0,0 +1,213 @@
+package views
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/kpumuk/lazykiq/internal/config"
+	"github.com/kpumuk/lazykiq/internal/sidekiq"
+	"github.com/kpumuk/lazykiq/internal/ui/components/table"
+	"github.com/kpumuk/lazykiq/internal/ui/format"
+)
+
+// itemColumnPrefix selects an arbitrary payload key as a column (e.g. "item.tenant_id").
+const itemColumnPrefix = "item."
+
+// ColumnConfigurable is implemented by views whose table columns can be
+// changed from the config file.
+type ColumnConfigurable interface {
+	SetColumns(columns []config.Column) error
+}
+
+// jobColumn describes a table column rendered from a row of type T.
+type jobColumn[T any] struct {
+	name  string
+	title string
+	width int
+	value func(T) string
+}
+
+// columnSet holds the available and currently visible columns for a job table.
+type columnSet[T any] struct {
+	available []jobColumn[T]
+	record    func(T) *sidekiq.JobRecord
+	visible   []jobColumn[T]
+}
+
+// newColumnSet builds a column set from view-specific columns plus the
+// optional columns backed by JobRecord accessors. defaults lists the
+// columns shown when the config file does not override them.
+func newColumnSet[T any](specific []jobColumn[T], record func(T) *sidekiq.JobRecord, defaults []string) columnSet[T] {
+	available := make([]jobColumn[T], 0, len(specific)+len(recordColumns))
+	available = append(available, specific...)
+	for _, col := range recordColumns {
+		if hasColumn(available, col.name) {
+			continue
+		}
+		fn := col.value
+		available = append(available, jobColumn[T]{
+			name:  col.name,
+			title: col.title,
+			width: col.width,
+			value: func(row T) string { return fn(record(row)) },
+		})
+	}
+
+	c := columnSet[T]{available: available, record: record}
+	for _, name := range defaults {
+		if col, ok := c.lookup(name); ok {
+			c.visible = append(c.visible, col)
+		}
+	}
+	return c
+}
+
+// configure replaces the visible columns with the configured list.
+// An empty list keeps the current columns.
+func (c *columnSet[T]) configure(configured []config.Column) error {
+	if len(configured) == 0 {
+		return nil
+	}
+
+	visible := make([]jobColumn[T], 0, len(configured))
+	for _, cfg := range configured {
+		col, ok := c.lookup(cfg.Name)
+		if !ok {
+			return fmt.Errorf("unknown column %q (available: %s, %s<key>)", cfg.Name, strings.Join(c.names(), ", "), itemColumnPrefix)
+		}
+		if cfg.Title != "" {
+			col.title = cfg.Title
+		}
+		if cfg.Width > 0 {
+			col.width = cfg.Width
+		}
+		visible = append(visible, col)
+	}
+	c.visible = visible
+	return nil
+}
+
+// tableColumns returns the visible columns for the table component.
+func (c columnSet[T]) tableColumns() []table.Column {
+	cols := make([]table.Column, len(c.visible))
+	for i, col := range c.visible {
+		cols[i] = table.Column{Title: col.title, Width: col.width}
+	}
+	return cols
+}
+
+// row renders a table row for the visible columns.
+func (c columnSet[T]) row(value T) table.Row {
+	row := make(table.Row, len(c.visible))
+	for i, col := range c.visible {
+		row[i] = col.value(value)
+	}
+	return row
+}
+
+func (c columnSet[T]) lookup(name string) (jobColumn[T], bool) {
+	for _, col := range c.available {
+		if col.name == name {
+			return col, true
+		}
+	}
+
+	if key, ok := strings.CutPrefix(name, itemColumnPrefix); ok && key != "" {
+		record := c.record
+		return jobColumn[T]{
+			name:  name,
+			title: key,
+			width: 12,
+			value: func(row T) string { return itemValue(record(row), key) },
+		}, true
+	}
+
+	return jobColumn[T]{}, false
+}
+
+func (c columnSet[T]) names() []string {
+	names := make([]string, len(c.available))
+	for i, col := range c.available {
+		names[i] = col.name
+	}
+	return names
+}
+
+func hasColumn[T any](cols []jobColumn[T], name string) bool {
+	for _, col := range cols {
+		if col.name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// recordColumns are optional columns available in every job table.
+var recordColumns = []jobColumn[*sidekiq.JobRecord]{
+	{name: "jid", title: "JID", width: 24, value: (*sidekiq.JobRecord).JID},
+	{name: "queue", title: "Queue", width: 15, value: (*sidekiq.JobRecord).Queue},
+	{name: "class", title: "Job", width: 30, value: (*sidekiq.JobRecord).DisplayClass},
+	{name: "args", title: "Arguments", width: 40, value: func(job *sidekiq.JobRecord) string {
+		return format.Args(job.DisplayArgs())
+	}},
+	{name: "error", title: "Error", width: 60, value: formatJobError},
+	{name: "bid", title: "BID", width: 16, value: (*sidekiq.JobRecord).Bid},
+	{name: "tags", title: "Tags", width: 20, value: func(job *sidekiq.JobRecord) string {
+		return strings.Join(job.Tags(), ", ")
+	}},
+	{name: "retry_count", title: "Retries", width: 7, value: func(job *sidekiq.JobRecord) string {
+		return fmt.Sprintf("%d", job.RetryCount())
+	}},
+	{name: "created_at", title: "Created", width: 19, value: func(job *sidekiq.JobRecord) string {
+		return formatJobTimestamp(job.CreatedAt())
+	}},
+	{name: "enqueued_at", title: "Enqueued", width: 19, value: func(job *sidekiq.JobRecord) string {
+		return formatJobTimestamp(job.EnqueuedAt())
+	}},
+	{name: "failed_at", title: "Failed", width: 19, value: func(job *sidekiq.JobRecord) string {
+		return formatJobTimestamp(job.FailedAt())
+	}},
+	{name: "context", title: "Context", width: 40, value: func(job *sidekiq.JobRecord) string {
+		return formatContext(job.Context())
+	}},
+}
+
+// formatJobError formats the error class and message, truncated for table display.
+func formatJobError(job *sidekiq.JobRecord) string {
+	if !job.HasError() {
+		return ""
+	}
+	errorStr := fmt.Sprintf("%s: %s", job.ErrorClass(), job.ErrorMessage())
+	if len(errorStr) > 100 {
+		errorStr = errorStr[:97] + "..."
+	}
+	return errorStr
+}
+
+// formatJobTimestamp formats a Sidekiq timestamp (seconds or milliseconds).
+func formatJobTimestamp(ts float64) string {
+	if ts <= 0 {
+		return ""
+	}
+	return format.Timestamp(ts)
+}
+
+// itemValue renders an arbitrary payload key for table display.
+func itemValue(job *sidekiq.JobRecord, key string) string {
+	value, ok := job.Item()[key]
+	if !ok || value == nil {
+		return ""
+	}
+	if s, ok := value.(string); ok {
+		return s
+	}
+	return format.Args([]any{value})
+}
+
+func sortedEntryRecord(entry *sidekiq.SortedEntry) *sidekiq.JobRecord {
+	return entry.JobRecord
+}
+
+func positionedEntryRecord(entry *sidekiq.PositionedEntry) *sidekiq.JobRecord {
+	return entry.JobRecord
+}
@@ -0,0 +1,200 @@
+package views
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/kpumuk/lazykiq/internal/config"
+	"github.com/kpumuk/lazykiq/internal/sidekiq"
+	"github.com/kpumuk/lazykiq/internal/ui/components/table"
+)
+
+var testSpecificColumns = []jobColumn[*sidekiq.SortedEntry]{
+	{name: "when", title: "When", width: 12, value: func(*sidekiq.SortedEntry) string {
+		return "soon"
+	}},
+	{name: "class", title: "Worker", width: 10, value: func(entry *sidekiq.SortedEntry) string {
+		return "worker:" + entry.Klass()
+	}},
+}
+
+func newTestColumnSet(defaults ...string) columnSet[*sidekiq.SortedEntry] {
+	return newColumnSet(testSpecificColumns, sortedEntryRecord, defaults)
+}
+
+func TestNewColumnSet(t *testing.T) {
+	tests := []struct {
+		name     string
+		defaults []string
+		want     []table.Column
+	}{
+		{
+			name:     "DefaultOrder",
+			defaults: []string{"queue", "when", "jid"},
+			want: []table.Column{
+				{Title: "Queue", Width: 15},
+				{Title: "When", Width: 12},
+				{Title: "JID", Width: 24},
+			},
+		},
+		{
+			name:     "SpecificOverridesRecordColumn",
+			defaults: []string{"class"},
+			want:     []table.Column{{Title: "Worker", Width: 10}},
+		},
+		{
+			name:     "UnknownDefaultSkipped",
+			defaults: []string{"missing", "args"},
+			want:     []table.Column{{Title: "Arguments", Width: 40}},
+		},
+		{
+			name:     "ItemDefault",
+			defaults: []string{"item.tenant_id"},
+			want:     []table.Column{{Title: "tenant_id", Width: 12}},
+		},
+		{
+			name: "NoDefaults",
+			want: []table.Column{},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got := newTestColumnSet(tc.defaults...).tableColumns()
+			if !slices.Equal(got, tc.want) {
+				t.Fatalf("want %+v, got %+v", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestNewColumnSet_AvailableNamesUnique(t *testing.T) {
+	names := newTestColumnSet().names()
+	seen := make(map[string]bool)
+	for _, name := range names {
+		if seen[name] {
+			t.Fatalf("column %q listed twice in %v", name, names)
+		}
+		seen[name] = true
+	}
+	if names[0] != "when" || names[1] != "class" {
+		t.Fatalf("want view-specific columns first, got %v", names)
+	}
+}
+
+func TestColumnSetConfigure(t *testing.T) {
+	defaults := []string{"when", "class"}
+	tests := []struct {
+		name       string
+		configured []config.Column
+		want       []table.Column
+		wantErr    string
+	}{
+		{
+			name:       "EmptyKeepsDefaults",
+			configured: nil,
+			want: []table.Column{
+				{Title: "When", Width: 12},
+				{Title: "Worker", Width: 10},
+			},
+		},
+		{
+			name: "Reorder",
+			configured: []config.Column{
+				{Name: "class"},
+				{Name: "jid"},
+				{Name: "when"},
+			},
+			want: []table.Column{
+				{Title: "Worker", Width: 10},
+				{Title: "JID", Width: 24},
+				{Title: "When", Width: 12},
+			},
+		},
+		{
+			name: "TitleAndWidthOverrides",
+			configured: []config.Column{
+				{Name: "queue", Title: "Q"},
+				{Name: "args", Width: 80},
+				{Name: "item.tenant_id", Title: "Tenant", Width: 8},
+			},
+			want: []table.Column{
+				{Title: "Q", Width: 15},
+				{Title: "Arguments", Width: 80},
+				{Title: "Tenant", Width: 8},
+			},
+		},
+		{
+			name:       "UnknownColumn",
+			configured: []config.Column{{Name: "queue"}, {Name: "missing"}},
+			want: []table.Column{
+				{Title: "When", Width: 12},
+				{Title: "Worker", Width: 10},
+			},
+			wantErr: `unknown column "missing"`,
+		},
+		{
+			name:       "EmptyItemKey",
+			configured: []config.Column{{Name: "item."}},
+			want: []table.Column{
+				{Title: "When", Width: 12},
+				{Title: "Worker", Width: 10},
+			},
+			wantErr: `unknown column "item."`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			columns := newTestColumnSet(defaults...)
+			err := columns.configure(tc.configured)
+			switch {
+			case tc.wantErr == "" && err != nil:
+				t.Fatalf("unexpected error: %v", err)
+			case tc.wantErr != "" && (err == nil || !strings.Contains(err.Error(), tc.wantErr)):
+				t.Fatalf("want error containing %q, got %v", tc.wantErr, err)
+			}
+			if got := columns.tableColumns(); !slices.Equal(got, tc.want) {
+				t.Fatalf("want %+v, got %+v", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestColumnSetRow(t *testing.T) {
+	entry := sidekiq.NewSortedEntry(`{"jid":"abc123","class":"HardJob","queue":"critical","args":[1],"tenant_id":42,"region":"eu"}`, 0)
+	tests := []struct {
+		name       string
+		configured []config.Column
+		want       table.Row
+	}{
+		{
+			name:       "VisibleOrder",
+			configured: []config.Column{{Name: "queue"}, {Name: "jid"}, {Name: "when"}},
+			want:       table.Row{"critical", "abc123", "soon"},
+		},
+		{
+			name:       "SpecificValue",
+			configured: []config.Column{{Name: "class"}},
+			want:       table.Row{"worker:HardJob"},
+		},
+		{
+			name:       "ItemValues",
+			configured: []config.Column{{Name: "item.region"}, {Name: "item.tenant_id"}, {Name: "item.missing"}},
+			want:       table.Row{"eu", "42", ""},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			columns := newTestColumnSet()
+			if err := columns.configure(tc.configured); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := columns.row(entry); !slices.Equal(got, tc.want) {
+				t.Fatalf("want %q, got %q", tc.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,136 @@
+package views
+
+import (
+	"strconv"
+	"strings"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/kpumuk/lazykiq/internal/sidekiq"
+	"github.com/kpumuk/lazykiq/internal/ui/components/frame"
+	"github.com/kpumuk/lazykiq/internal/ui/components/table"
+	"github.com/kpumuk/lazykiq/internal/ui/format"
+)
+
+var coverageColumns = []table.Column{
+	{Title: "Queue", Width: 20},
+	{Title: "Size", Width: 8},
+	{Title: "Threads", Width: 7},
+	{Title: "Effective", Width: 9},
+	{Title: "Status", Width: 16},
+	{Title: "Processes", Width: 40},
+}
+
+// updateCoverageRows maps queues to the processes listening to them,
+// highlighting queues nobody works off and queues that do not exist.
+// Effective threads account for capsule weights, so a queue sharing a
+// capsule with heavier queues gets a fraction of its threads.
+func (q *Queues) updateCoverageRows() {
+	sizes := make(map[string]int64, len(q.queues))
+	for _, queue := range q.queues {
+		sizes[queue.Name] = queue.Size
+	}
+	q.coverage = sidekiq.BuildQueueCoverage(sizes, q.processes, q.processesAt)
+
+	rows := make([]table.Row, len(q.coverage))
+	highlighted := make([]bool, len(q.coverage))
+	for i, queue := range q.coverage {
+		status := "ok"
+		switch {
+		case queue.Orphaned():
+			status = "no process"
+		case queue.Unknown():
+			status = "unknown queue"
+		case len(queue.Processes) == 0:
+			status = "idle"
+		}
+		processes := make([]string, len(queue.Processes))
+		for j, identity := range queue.Processes {
+			processes[j] = shortIdentity(identity)
+		}
+		size := format.Number(queue.Size)
+		if !queue.Exists {
+			size = "-"
+		}
+		rows[i] = table.Row{
+			queue.Queue,
+			size,
+			format.Number(int64(queue.Concurrency)),
+			strconv.FormatFloat(queue.Effective, 'f', 1, 64),
+			status,
+			strings.Join(processes, ", "),
+		}
+		highlighted[i] = queue.Orphaned() || queue.Unknown()
+	}
+	q.coverageTable.SetRows(rows)
+	q.coverageTable.SetHighlighted(highlighted)
+}
+
+// selectCoverageQueue shows the jobs of the queue under the cursor.
+func (q *Queues) selectCoverageQueue() tea.Cmd {
+	idx := q.coverageTable.Cursor()
+	if idx < 0 || idx >= len(q.coverage) {
+		return nil
+	}
+	for i, queue := range q.queues {
+		if queue.Name == q.coverage[idx].Queue {
+			q.showCoverage = false
+			q.selectedQueue = i
+			q.currentPage = 1
+			return q.fetchDataCmd()
+		}
+	}
+	return nil
+}
+
+// shortIdentity trims the nonce from a process identity (hostname:pid:nonce).
+func shortIdentity(identity string) string {
+	parts := strings.Split(identity, ":")
+	if len(parts) >= 2 {
+		return parts[0] + ":" + parts[1]
+	}
+	return identity
+}
+
+// renderCoverageBox renders the queue to process coverage map.
+func (q *Queues) renderCoverageBox() string {
+	orphaned, unknown := 0, 0
+	for _, queue := range q.coverage {
+		if queue.Orphaned() {
+			orphaned++
+		}
+		if queue.Unknown() {
+			unknown++
+		}
+	}
+	sep := q.styles.Muted.Render(" • ")
+	count := func(n int) string {
+		if n > 0 {
+			return q.styles.ChartFailure.Bold(true).Render(format.Number(int64(n)))
+		}
+		return q.styles.MetricValue.Render("0")
+	}
+	meta := q.styles.MetricLabel.Render("PRC: ") + q.styles.MetricValue.Render(format.Number(int64(len(q.processes)))) +
+		sep + q.styles.MetricLabel.Render("NO PROCESS: ") + count(orphaned) +
+		sep + q.styles.MetricLabel.Render("UNKNOWN: ") + count(unknown)
+
+	return frame.New(
+		frame.WithStyles(frame.Styles{
+			Focused: frame.StyleState{
+				Title:  q.styles.Title,
+				Border: q.styles.FocusBorder,
+			},
+			Blurred: frame.StyleState{
+				Title:  q.styles.Title,
+				Border: q.styles.BorderStyle,
+			},
+		}),
+		frame.WithTitle("Queue Coverage"),
+		frame.WithTitlePadding(0),
+		frame.WithMeta(meta),
+		frame.WithContent(q.coverageTable.View()),
+		frame.WithPadding(1),
+		frame.WithSize(q.width, q.height-len(q.queues)-q.chartHeight()),
+		frame.WithMinHeight(5),
+		frame.WithFocused(true),
+	).View()
+}
@@ -2,16 +2,21 @@ package views
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"image/color"
+	"io/fs"
+	"os"
 	"strings"
 	"time"
 
 	"charm.land/bubbles/v2/key"
 	tea "charm.land/bubbletea/v2"
 	"charm.land/lipgloss/v2"
-	tslc "github.com/NimbleMarkets/ntcharts/linechart/timeserieslinechart"
 	oldgloss "github.com/charmbracelet/lipgloss"
+	"github.com/kpumuk/lazykiq/internal/history"
 	"github.com/kpumuk/lazykiq/internal/sidekiq"
+	"github.com/kpumuk/lazykiq/internal/ui/components/daterange"
 	"github.com/kpumuk/lazykiq/internal/ui/components/frame"
 	"github.com/kpumuk/lazykiq/internal/ui/format"
 )
@@ -21,6 +26,14 @@ const (
 	dashboardPaneHistory
 )
 
+// realtimeGapLimit is the longest gap between recorded samples whose totals
+// are still compared. Larger gaps (e.g. while lazykiq was not running)
+// start a new baseline instead of showing a spike.
+const realtimeGapLimit = time.Minute
+
+// maxExportSuffix bounds the numeric suffixes tried for export file names.
+const maxExportSuffix = 100
+
 // DashboardRealtimeMsg carries realtime dashboard data.
 type DashboardRealtimeMsg struct {
 	Snapshot sidekiq.DashboardRealtime
@@ -31,6 +44,17 @@ type DashboardHistoryMsg struct {
 	history sidekiq.StatsHistory
 }
 
+// dashboardSamplesMsg carries realtime samples recorded in previous runs.
+type dashboardSamplesMsg struct {
+	samples []history.Sample
+}
+
+// dashboardExportedMsg reports the result of a history export.
+type dashboardExportedMsg struct {
+	path string
+	err  error
+}
+
 // DashboardTickMsg is emitted by the realtime ticker.
 type DashboardTickMsg struct {
 	id int
@@ -45,6 +69,7 @@ type Dashboard struct {
 
 	focusedPane int
 	tickID      int
+	keys        DashboardKeyMap
 
 	realtimeInterval int
 	historyRanges    []int
@@ -60,10 +85,22 @@ type Dashboard struct {
 	realtimeProcessed []int64
 	realtimeFailed    []int64
 	realtimeTimes     []time.Time
+	// realtimeScroll is the number of newest points hidden while scrolled back.
+	realtimeScroll int
+
+	samples       *history.Store
+	samplesLoaded bool
 
 	historyDates     []time.Time
 	historyProcessed []int64
 	historyFailed    []int64
+	// historyStart and historyEnd hold a picked date range; when zero,
+	// the range comes from historyRanges.
+	historyStart    time.Time
+	historyEnd      time.Time
+	rangePicker     daterange.Model
+	showFailureRate bool
+	historyStatus   string
 
 	redisInfo sidekiq.RedisInfo
 }
@@ -73,20 +110,27 @@ func NewDashboard(client *sidekiq.Client) *Dashboard {
 	return &Dashboard{
 		client:           client,
 		focusedPane:      dashboardPaneRealtime,
+		keys:             DefaultDashboardKeyMap(),
 		realtimeInterval: 5,
 		historyRanges:    []int{7, 30, 90, 180},
 		historyRangeIdx:  1,
+		rangePicker:      daterange.New(),
 	}
 }
 
 // Init implements View.
 func (d *Dashboard) Init() tea.Cmd {
 	d.tickID++
-	return tea.Batch(
+	cmds := []tea.Cmd{
 		d.fetchRealtimeCmd(),
 		d.fetchHistoryCmd(),
 		d.realtimeTickCmd(),
-	)
+	}
+	if d.samples != nil && !d.samplesLoaded {
+		d.samplesLoaded = true
+		cmds = append(cmds, d.loadSamplesCmd())
+	}
+	return tea.Batch(cmds...)
 }
 
 // Update implements View.
@@ -100,6 +144,7 @@ func (d *Dashboard) Update(msg tea.Msg) (View, tea.Cmd) {
 
 	case DashboardRealtimeMsg:
 		d.redisInfo = msg.Snapshot.RedisInfo
+		saveCmd := d.saveSampleCmd(msg.Snapshot)
 
 		var deltaProcessed int64
 		var deltaFailed int64
@@ -117,12 +162,12 @@ func (d *Dashboard) Update(msg tea.Msg) (View, tea.Cmd) {
 		d.lastFailed = msg.Snapshot.Stats.Failed
 		if !d.hasLastTotals {
 			d.hasLastTotals = true
-			return d, nil
+			return d, saveCmd
 		}
 		d.hasLastTotals = true
 
 		if deltaProcessed == 0 && deltaFailed == 0 {
-			return d, nil
+			return d, saveCmd
 		}
 
 		d.lastPollAt = msg.Snapshot.FetchedAt
@@ -131,7 +176,31 @@ func (d *Dashboard) Update(msg tea.Msg) (View, tea.Cmd) {
 		d.realtimeProcessed = append(d.realtimeProcessed, deltaProcessed)
 		d.realtimeFailed = append(d.realtimeFailed, deltaFailed)
 		d.realtimeTimes = append(d.realtimeTimes, msg.Snapshot.FetchedAt)
+		if d.realtimeScroll > 0 {
+			// Keep the scrolled-back window in place
+			d.realtimeScroll++
+		}
 		d.trimRealtimeSeries()
+		return d, saveCmd
+
+	case dashboardSamplesMsg:
+		d.mergeSamples(msg.samples)
+		return d, nil
+
+	case daterange.ActionMsg:
+		if msg.Action == daterange.ActionApply {
+			d.historyStart = msg.Start
+			d.historyEnd = msg.End
+			return d, d.fetchHistoryCmd()
+		}
+		return d, nil
+
+	case dashboardExportedMsg:
+		if msg.err != nil {
+			d.historyStatus = "Export failed: " + msg.err.Error()
+		} else {
+			d.historyStatus = "Exported to " + msg.path
+		}
 		return d, nil
 
 	case DashboardHistoryMsg:
@@ -143,20 +212,65 @@ func (d *Dashboard) Update(msg tea.Msg) (View, tea.Cmd) {
 	case RefreshMsg:
 		return d, nil
 
+	case tea.MouseClickMsg:
+		if msg.Button != tea.MouseLeft {
+			return d, nil
+		}
+		// Redis info line, then the realtime pane above the history pane
+		topHeight := d.realtimePaneHeight()
+		switch {
+		case msg.Y >= 1 && msg.Y < 1+topHeight:
+			d.focusedPane = dashboardPaneRealtime
+		case msg.Y >= 1+topHeight:
+			d.focusedPane = dashboardPaneHistory
+		}
+		return d, nil
+
 	case tea.KeyMsg:
-		switch msg.String() {
-		case "tab":
+		d.historyStatus = ""
+		if d.rangePicker.Active() {
+			var cmd tea.Cmd
+			d.rangePicker, cmd = d.rangePicker.Update(msg)
+			return d, cmd
+		}
+		switch {
+		case key.Matches(msg, d.keys.SwitchPane):
 			if d.focusedPane == dashboardPaneRealtime {
 				d.focusedPane = dashboardPaneHistory
 			} else {
 				d.focusedPane = dashboardPaneRealtime
 			}
 			return d, nil
-		case "[":
+		case key.Matches(msg, d.keys.Shorter):
 			return d.adjustFocusedPane(-1)
-		case "]":
+		case key.Matches(msg, d.keys.Longer):
 			return d.adjustFocusedPane(1)
 		}
+		if d.focusedPane == dashboardPaneRealtime {
+			page := max(d.chartContentWidth()/2, 1)
+			switch {
+			case key.Matches(msg, d.keys.ScrollBack):
+				d.scrollRealtime(page)
+			case key.Matches(msg, d.keys.ScrollForward):
+				d.scrollRealtime(-page)
+			case key.Matches(msg, d.keys.Oldest):
+				d.realtimeScroll = d.maxRealtimeScroll()
+			case key.Matches(msg, d.keys.Live):
+				d.realtimeScroll = 0
+			}
+		}
+		if d.focusedPane == dashboardPaneHistory {
+			switch {
+			case key.Matches(msg, d.keys.PickRange):
+				d.openRangePicker()
+			case key.Matches(msg, d.keys.FailureRate):
+				d.showFailureRate = !d.showFailureRate
+			case key.Matches(msg, d.keys.ExportCSV):
+				return d, d.exportHistoryCmd("csv")
+			case key.Matches(msg, d.keys.ExportJSON):
+				return d, d.exportHistoryCmd("json")
+			}
+		}
 	}
 
 	return d, nil
@@ -177,8 +291,11 @@ func (d *Dashboard) adjustFocusedPane(delta int) (View, tea.Cmd) {
 		if next >= len(d.historyRanges) {
 			next = len(d.historyRanges) - 1
 		}
-		if next != d.historyRangeIdx {
+		if next != d.historyRangeIdx || d.customHistoryRange() {
+			// Fixed ranges replace a picked date range
 			d.historyRangeIdx = next
+			d.historyStart = time.Time{}
+			d.historyEnd = time.Time{}
 			return d, d.fetchHistoryCmd()
 		}
 	}
@@ -194,7 +311,7 @@ func (d *Dashboard) View() string {
 	redisLine := d.renderRedisInfoLine()
 	remaining := max(d.height-1, 2)
 
-	topHeight := remaining / 2
+	topHeight := d.realtimePaneHeight()
 	bottomHeight := remaining - topHeight
 
 	realtimeBox := d.renderRealtimeBox(topHeight)
@@ -203,6 +320,12 @@ func (d *Dashboard) View() string {
 	return lipgloss.JoinVertical(lipgloss.Left, redisLine, realtimeBox, historyBox)
 }
 
+// realtimePaneHeight returns the height of the realtime pane, which shares
+// the space below the Redis info line with the history pane.
+func (d *Dashboard) realtimePaneHeight() int {
+	return max(d.height-1, 2) / 2
+}
+
 // Name implements View.
 func (d *Dashboard) Name() string {
 	return "Dashboard"
@@ -213,31 +336,75 @@ func (d *Dashboard) ShortHelp() []key.Binding {
 	return nil
 }
 
+// FullHelp implements View.
+func (d *Dashboard) FullHelp() []HelpSection {
+	return []HelpSection{
+		{
+			Title: "Dashboard",
+			Bindings: []key.Binding{
+				d.keys.SwitchPane,
+				d.keys.Shorter,
+				d.keys.Longer,
+				d.keys.ScrollBack,
+				d.keys.ScrollForward,
+				d.keys.Oldest,
+				d.keys.Live,
+				d.keys.PickRange,
+				d.keys.FailureRate,
+				d.keys.ExportCSV,
+				d.keys.ExportJSON,
+			},
+		},
+	}
+}
+
 // SetSize implements View.
 func (d *Dashboard) SetSize(width, height int) View {
 	d.width = width
 	d.height = height
-	d.seedRealtimeSeries()
 	d.trimRealtimeSeries()
 	return d
 }
 
+// SetKeyMaps implements KeyMapConfigurable.
+func (d *Dashboard) SetKeyMaps(keys KeyMaps) {
+	d.keys = keys.Dashboard
+	d.rangePicker.KeyMap = keys.DateRange
+}
+
+// SetHistory implements HistoryConfigurable.
+func (d *Dashboard) SetHistory(store *history.Store) {
+	d.samples = store
+	d.samplesLoaded = false
+}
+
 // SetStyles implements View.
 func (d *Dashboard) SetStyles(styles Styles) View {
 	d.styles = styles
+	d.rangePicker.SetStyles(daterange.Styles{
+		Label:    styles.MetricLabel,
+		Value:    styles.MetricValue,
+		Selected: styles.TableSelected,
+		Muted:    styles.Muted,
+	})
 	return d
 }
 
+// FilterFocused reports whether the date range picker is capturing keys.
+func (d *Dashboard) FilterFocused() bool {
+	return d.rangePicker.Active()
+}
+
 func (d *Dashboard) realtimeTickCmd() tea.Cmd {
 	id := d.tickID
 	interval := time.Duration(d.realtimeInterval) * time.Second
-	return tea.Tick(interval, func(time.Time) tea.Msg {
+	return ClientCmd(d.client, tea.Tick(interval, func(time.Time) tea.Msg {
 		return DashboardTickMsg{id: id}
-	})
+	}))
 }
 
 func (d *Dashboard) fetchRealtimeCmd() tea.Cmd {
-	return func() tea.Msg {
+	return ClientCmd(d.client, func() tea.Msg {
 		ctx := context.Background()
 		snapshot, err := d.client.GetDashboardRealtime(ctx)
 		if err != nil {
@@ -246,19 +413,196 @@ func (d *Dashboard) fetchRealtimeCmd() tea.Cmd {
 		return DashboardRealtimeMsg{
 			Snapshot: snapshot,
 		}
-	}
+	})
 }
 
 func (d *Dashboard) fetchHistoryCmd() tea.Cmd {
-	return func() tea.Msg {
+	days := d.historyRanges[d.historyRangeIdx]
+	start, end := d.historyStart, d.historyEnd
+	return ClientCmd(d.client, func() tea.Msg {
 		ctx := context.Background()
-		days := d.historyRanges[d.historyRangeIdx]
-		history, err := d.client.GetStatsHistory(ctx, days)
+		var stats sidekiq.StatsHistory
+		var err error
+		if start.IsZero() {
+			stats, err = d.client.GetStatsHistory(ctx, days)
+		} else {
+			stats, err = d.client.GetStatsHistoryRange(ctx, start, end)
+		}
 		if err != nil {
 			return ConnectionErrorMsg{Err: err}
 		}
-		return DashboardHistoryMsg{history: history}
+		return DashboardHistoryMsg{history: stats}
+	})
+}
+
+func (d *Dashboard) customHistoryRange() bool {
+	return !d.historyStart.IsZero()
+}
+
+// openRangePicker opens the date range picker on the displayed range.
+func (d *Dashboard) openRangePicker() {
+	end := time.Now()
+	start := end.AddDate(0, 0, -(d.historyRanges[d.historyRangeIdx] - 1))
+	if len(d.historyDates) > 0 {
+		start, end = d.historyDates[0], d.historyDates[len(d.historyDates)-1]
+	}
+	d.rangePicker.Open(start, end)
+}
+
+// exportHistoryCmd writes the displayed history to a new CSV or JSON file
+// in the working directory.
+func (d *Dashboard) exportHistoryCmd(ext string) tea.Cmd {
+	if len(d.historyDates) == 0 {
+		return nil
+	}
+	stats := sidekiq.StatsHistory{
+		Dates:     d.historyDates,
+		Processed: d.historyProcessed,
+		Failed:    d.historyFailed,
+	}
+	base := fmt.Sprintf("lazykiq-stats-%s-%s",
+		stats.Dates[0].Format("20060102"), stats.Dates[len(stats.Dates)-1].Format("20060102"))
+	return func() tea.Msg {
+		file, path, err := createExportFile(base, ext)
+		if err != nil {
+			return dashboardExportedMsg{err: err}
+		}
+		if ext == "json" {
+			err = stats.WriteJSON(file)
+		} else {
+			err = stats.WriteCSV(file)
+		}
+		if closeErr := file.Close(); err == nil {
+			err = closeErr
+		}
+		return dashboardExportedMsg{path: path, err: err}
+	}
+}
+
+// createExportFile creates a new file named after base, adding a numeric
+// suffix rather than overwriting an earlier export of the same days.
+func createExportFile(base, ext string) (*os.File, string, error) {
+	path := base + "." + ext
+	for i := 1; ; i++ {
+		file, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o644)
+		if !errors.Is(err, fs.ErrExist) || i > maxExportSuffix {
+			return file, path, err
+		}
+		path = fmt.Sprintf("%s-%d.%s", base, i, ext)
+	}
+}
+
+// loadSamplesCmd reads realtime samples recorded in previous runs.
+func (d *Dashboard) loadSamplesCmd() tea.Cmd {
+	store := d.samples
+	return func() tea.Msg {
+		// History is best effort: an unreadable file only loses scroll-back.
+		samples, _ := store.Load(time.Now())
+		return dashboardSamplesMsg{samples: samples}
+	}
+}
+
+// saveSampleCmd appends the snapshot to the history file.
+func (d *Dashboard) saveSampleCmd(snapshot sidekiq.DashboardRealtime) tea.Cmd {
+	store := d.samples
+	if store == nil {
+		return nil
+	}
+	sample := history.Sample{
+		At:             snapshot.FetchedAt,
+		Processed:      snapshot.Stats.Processed,
+		Failed:         snapshot.Stats.Failed,
+		Enqueued:       snapshot.Stats.Enqueued,
+		Busy:           snapshot.Stats.Busy,
+		Retries:        snapshot.Stats.Retries,
+		Dead:           snapshot.Stats.Dead,
+		QueueLatencies: snapshot.QueueLatencies,
+	}
+	return func() tea.Msg {
+		// History is best effort: a read-only cache directory should not
+		// interrupt monitoring.
+		_ = store.Append(sample)
+		return nil
+	}
+}
+
+// mergeSamples prepends points computed from recorded samples to the
+// realtime series, keeping live points newer than the last sample.
+func (d *Dashboard) mergeSamples(samples []history.Sample) {
+	times, processed, failed := realtimePoints(samples)
+	if len(times) == 0 {
+		return
+	}
+	last := times[len(times)-1]
+	keep := 0
+	for keep < len(d.realtimeTimes) && !d.realtimeTimes[keep].After(last) {
+		keep++
+	}
+	d.realtimeTimes = append(times, d.realtimeTimes[keep:]...)
+	d.realtimeProcessed = append(processed, d.realtimeProcessed[keep:]...)
+	d.realtimeFailed = append(failed, d.realtimeFailed[keep:]...)
+	d.trimRealtimeSeries()
+}
+
+// realtimePoints converts consecutive sample totals into processed and
+// failed deltas, mirroring how live updates are charted.
+func realtimePoints(samples []history.Sample) ([]time.Time, []int64, []int64) {
+	var times []time.Time
+	var processed, failed []int64
+	for i := 1; i < len(samples); i++ {
+		prev, cur := samples[i-1], samples[i]
+		if gap := cur.At.Sub(prev.At); gap <= 0 || gap > realtimeGapLimit {
+			continue
+		}
+		deltaProcessed := max(cur.Processed-prev.Processed, 0)
+		deltaFailed := max(cur.Failed-prev.Failed, 0)
+		if deltaProcessed == 0 && deltaFailed == 0 {
+			continue
+		}
+		times = append(times, cur.At)
+		processed = append(processed, deltaProcessed)
+		failed = append(failed, deltaFailed)
+	}
+	return times, processed, failed
+}
+
+// scrollRealtime moves the realtime window back (positive delta) or
+// forward in time.
+func (d *Dashboard) scrollRealtime(delta int) {
+	d.realtimeScroll = max(min(d.realtimeScroll+delta, d.maxRealtimeScroll()), 0)
+}
+
+func (d *Dashboard) maxRealtimeScroll() int {
+	return max(len(d.realtimeTimes)-d.chartContentWidth(), 0)
+}
+
+// realtimeWindow returns the points visible in the realtime chart, padded
+// with empty points before the oldest one so the chart spans the full width.
+func (d *Dashboard) realtimeWindow() ([]time.Time, []int64, []int64) {
+	width := d.chartContentWidth()
+	end := len(d.realtimeTimes) - d.realtimeScroll
+	start := max(end-width, 0)
+
+	missing := width - (end - start)
+	times := make([]time.Time, 0, width)
+	processed := make([]int64, 0, width)
+	failed := make([]int64, 0, width)
+	if missing > 0 {
+		first := time.Now()
+		if end > start {
+			first = d.realtimeTimes[start]
+		}
+		interval := time.Duration(d.realtimeInterval) * time.Second
+		for i := missing; i > 0; i-- {
+			times = append(times, first.Add(-interval*time.Duration(i)))
+			processed = append(processed, 0)
+			failed = append(failed, 0)
+		}
 	}
+	times = append(times, d.realtimeTimes[start:end]...)
+	processed = append(processed, d.realtimeProcessed[start:end]...)
+	failed = append(failed, d.realtimeFailed[start:end]...)
+	return times, processed, failed
 }
 
 func (d *Dashboard) renderRedisInfoLine() string {
@@ -278,6 +622,9 @@ func (d *Dashboard) renderRedisInfoLine() string {
 
 func (d *Dashboard) renderRealtimeBox(height int) string {
 	meta := d.styles.MetricLabel.Render("interval: ") + d.styles.MetricValue.Render(fmt.Sprintf("%ds", d.realtimeInterval))
+	if d.realtimeScroll > 0 {
+		meta += d.styles.Muted.Render(" • ") + d.styles.MetricLabel.Render("history: ") + d.styles.MetricValue.Render("-"+format.Duration(int64(d.realtimeScrollAge().Seconds())))
+	}
 	content := d.renderRealtimeContent(height - 2)
 	box := frame.New(
 		frame.WithStyles(frame.Styles{
@@ -304,6 +651,9 @@ func (d *Dashboard) renderRealtimeBox(height int) string {
 
 func (d *Dashboard) renderHistoryBox(height int) string {
 	meta := d.styles.MetricLabel.Render("range: ") + d.styles.MetricValue.Render(d.historyRangeLabel())
+	if d.historyStatus != "" {
+		meta = d.styles.Muted.Render(d.historyStatus)
+	}
 	content := d.renderHistoryContent(height - 2)
 	box := frame.New(
 		frame.WithStyles(frame.Styles{
@@ -333,17 +683,14 @@ func (d *Dashboard) renderRealtimeContent(contentHeight int) string {
 	if contentHeight < 1 || width < 1 {
 		return ""
 	}
-	if len(d.realtimeProcessed) == 0 {
-		return renderCenteredLoading(width, contentHeight)
-	}
-
 	chartHeight := contentHeight - 1
 	if chartHeight < 1 {
 		return renderCenteredLoading(width, contentHeight)
 	}
 
-	chart := d.renderTimeSeriesChart(width, chartHeight, d.realtimeTimes, d.realtimeProcessed, d.realtimeFailed, realtimeTimeLabelFormatter())
-	legend := d.renderRealtimeLegend(width)
+	times, processed, failed := d.realtimeWindow()
+	chart := d.renderTimeSeriesChart(width, chartHeight, times, processed, failed, realtimeTimeLabelFormatter())
+	legend := d.renderRealtimeLegend(width, times, processed, failed)
 	return chart + "\n" + legend
 }
 
@@ -352,6 +699,9 @@ func (d *Dashboard) renderHistoryContent(contentHeight int) string {
 	if contentHeight < 1 || width < 1 {
 		return ""
 	}
+	if d.rangePicker.Active() {
+		return lipgloss.Place(width, contentHeight, lipgloss.Center, lipgloss.Center, d.rangePicker.View())
+	}
 	if len(d.historyProcessed) == 0 {
 		return renderCenteredLoading(width, contentHeight)
 	}
@@ -361,13 +711,27 @@ func (d *Dashboard) renderHistoryContent(contentHeight int) string {
 		return renderCenteredLoading(width, contentHeight)
 	}
 
-	chart := d.renderTimeSeriesChart(width, chartHeight, d.historyDates, d.historyProcessed, d.historyFailed, historyTimeLabelFormatter())
+	var chart string
+	if d.showFailureRate {
+		chart = renderLineChart(d.styles, width, chartHeight, d.historyDates, []chartSeries{
+			{name: "failure rate", values: failureRates(d.historyProcessed, d.historyFailed), style: oldStyle(d.styles.ChartFailure)},
+		}, historyTimeLabelFormatter(), percentYLabelFormatter())
+	} else {
+		chart = d.renderTimeSeriesChart(width, chartHeight, d.historyDates, d.historyProcessed, d.historyFailed, historyTimeLabelFormatter())
+	}
 	legend := d.renderHistoryLegend(width)
 	return chart + "\n" + legend
 }
 
-func (d *Dashboard) renderRealtimeLegend(width int) string {
+func (d *Dashboard) renderRealtimeLegend(width int, times []time.Time, processedSeries, failedSeries []int64) string {
 	sep := d.styles.Muted.Render(" | ")
+	if d.realtimeScroll > 0 && len(times) > 0 {
+		// Scrolled back: summarize the visible window instead of the last poll
+		processed := d.styles.MetricLabel.Render("Processed: ") + d.styles.MetricValue.Render(format.Number(sumSeries(processedSeries)))
+		failed := d.styles.MetricLabel.Render("Failed: ") + d.styles.MetricValue.Render(format.Number(sumSeries(failedSeries)))
+		window := d.styles.Muted.Render(times[0].Format("15:04:05") + ".." + times[len(times)-1].Format("15:04:05"))
+		return lipgloss.NewStyle().MaxWidth(width).Render(processed + sep + failed + sep + window)
+	}
 	processed := d.styles.MetricLabel.Render("Processed: ") + d.styles.MetricValue.Render(format.Number(d.lastDeltaP))
 	failed := d.styles.MetricLabel.Render("Failed: ") + d.styles.MetricValue.Render(format.Number(d.lastDeltaF))
 	timestamp := d.styles.Muted.Render(d.lastPollAt.Format("15:04:05"))
@@ -375,16 +739,29 @@ func (d *Dashboard) renderRealtimeLegend(width int) string {
 	return lipgloss.NewStyle().MaxWidth(width).Render(line)
 }
 
+// realtimeScrollAge returns how far back the newest visible point is.
+func (d *Dashboard) realtimeScrollAge() time.Duration {
+	end := len(d.realtimeTimes) - d.realtimeScroll
+	if end <= 0 || end > len(d.realtimeTimes) {
+		return 0
+	}
+	return time.Since(d.realtimeTimes[end-1])
+}
+
 func (d *Dashboard) renderHistoryLegend(width int) string {
 	sep := d.styles.Muted.Render(" | ")
 	processed := d.styles.MetricLabel.Render("Processed: ") + d.styles.MetricValue.Render(format.Number(sumSeries(d.historyProcessed)))
 	failed := d.styles.MetricLabel.Render("Failed: ") + d.styles.MetricValue.Render(format.Number(sumSeries(d.historyFailed)))
+	rate := d.styles.MetricLabel.Render("Failure rate: ") + d.styles.MetricValue.Render(formatPercent(failureRate(sumSeries(d.historyProcessed), sumSeries(d.historyFailed))))
 	rangeLabel := d.styles.Muted.Render(d.historyDateRangeLabel())
-	line := processed + sep + failed + sep + rangeLabel
+	line := processed + sep + failed + sep + rate + sep + rangeLabel
 	return lipgloss.NewStyle().MaxWidth(width).Render(line)
 }
 
 func (d *Dashboard) historyRangeLabel() string {
+	if d.customHistoryRange() {
+		return "custom"
+	}
 	if d.historyRangeIdx < 0 || d.historyRangeIdx >= len(d.historyRanges) {
 		return "1 month"
 	}
@@ -419,11 +796,22 @@ func (d *Dashboard) chartContentWidth() int {
 	return width
 }
 
+// trimRealtimeSeries keeps a chart width of points, or everything within
+// the history retention when history is recorded.
 func (d *Dashboard) trimRealtimeSeries() {
 	maxPoints := d.chartContentWidth()
+	if d.samples != nil {
+		cutoff := time.Now().Add(-d.samples.Retention())
+		expired := 0
+		for expired < len(d.realtimeTimes) && d.realtimeTimes[expired].Before(cutoff) {
+			expired++
+		}
+		maxPoints = len(d.realtimeTimes) - expired
+	}
 	d.realtimeProcessed = trimSeries(d.realtimeProcessed, maxPoints)
 	d.realtimeFailed = trimSeries(d.realtimeFailed, maxPoints)
 	d.realtimeTimes = trimTimes(d.realtimeTimes, maxPoints)
+	d.realtimeScroll = min(d.realtimeScroll, d.maxRealtimeScroll())
 }
 
 func trimSeries(values []int64, maxItems int) []int64 {
@@ -437,71 +825,63 @@ func trimSeries(values []int64, maxItems int) []int64 {
 }
 
 func (d *Dashboard) renderTimeSeriesChart(width, height int, times []time.Time, processed, failed []int64, xFormatter func(int, float64) string) string {
-	if width < 1 || height < 1 {
-		return ""
-	}
-	n := minInt(len(times), len(processed), len(failed))
-	if n == 0 {
-		return renderCenteredLoading(width, height)
-	}
-
-	times = times[len(times)-n:]
-	processed = processed[len(processed)-n:]
-	failed = failed[len(failed)-n:]
+	return renderLineChart(d.styles, width, height, times, []chartSeries{
+		{name: "processed", values: floatSeries(processed), style: oldStyle(d.styles.ChartSuccess)},
+		{name: "failed", values: floatSeries(failed), style: oldStyle(d.styles.ChartFailure)},
+	}, xFormatter, shortYLabelFormatter())
+}
 
-	minTime := times[0]
-	maxTime := times[len(times)-1]
-	if !maxTime.After(minTime) {
-		maxTime = minTime.Add(time.Second)
+// failureRate returns failed jobs as a percentage of processed jobs, which
+// include failures.
+func failureRate(processed, failed int64) float64 {
+	if processed <= 0 {
+		return 0
 	}
+	return float64(failed) / float64(processed) * 100
+}
 
-	maxVal := int64(1)
-	for i := range n {
-		if processed[i] > maxVal {
-			maxVal = processed[i]
-		}
-		if failed[i] > maxVal {
-			maxVal = failed[i]
+func failureRates(processed, failed []int64) []float64 {
+	rates := make([]float64, len(processed))
+	for i := range processed {
+		if i < len(failed) {
+			rates[i] = failureRate(processed[i], failed[i])
 		}
 	}
+	return rates
+}
 
-	// TODO: Switch to new lipgloss styles after ntcharts switches to lipgloss v2
-	muted := oldgloss.NewStyle().Foreground(oldgloss.AdaptiveColor{
-		Light: "#6B7280", // Gray-500
-		Dark:  "#9CA3AF", // Gray-400
-	})
-	success := oldgloss.NewStyle().Foreground(oldgloss.AdaptiveColor{
-		Light: "#16A34A",
-		Dark:  "#22C55E",
-	})
-	failure := oldgloss.NewStyle().Foreground(oldgloss.AdaptiveColor{
-		Light: "#FF0000",
-		Dark:  "#FF0000",
-	})
-
-	chart := tslc.New(width, height,
-		tslc.WithXYSteps(2, 2),
-		tslc.WithXLabelFormatter(xFormatter),
-		tslc.WithYLabelFormatter(shortYLabelFormatter()),
-		tslc.WithAxesStyles(muted, muted),
-		tslc.WithTimeRange(minTime, maxTime),
-		tslc.WithYRange(0, float64(maxVal)),
-	)
-	chart.AutoMinX = false
-	chart.AutoMaxX = false
-	chart.AutoMinY = false
-	chart.AutoMaxY = false
-	chart.SetStyle(success)
-	chart.SetDataSetStyle("failed", failure)
+func formatPercent(v float64) string {
+	if v > 0 && v < 10 {
+		return fmt.Sprintf("%.1f%%", v)
+	}
+	return fmt.Sprintf("%.0f%%", v)
+}
 
-	for i := range n {
-		pointTime := times[i]
-		chart.Push(tslc.TimePoint{Time: pointTime, Value: float64(processed[i])})
-		chart.PushDataSet("failed", tslc.TimePoint{Time: pointTime, Value: float64(failed[i])})
+func floatSeries(values []int64) []float64 {
+	result := make([]float64, len(values))
+	for i, v := range values {
+		result[i] = float64(v)
 	}
+	return result
+}
 
-	chart.DrawBrailleAll()
-	return chart.View()
+// oldStyle converts a lipgloss v2 style's foreground and boldness for ntcharts,
+// which still uses lipgloss v1.
+func oldStyle(style lipgloss.Style) oldgloss.Style {
+	return oldgloss.NewStyle().
+		Foreground(oldColor(style.GetForeground())).
+		Bold(style.GetBold())
+}
+
+func oldColor(c color.Color) oldgloss.TerminalColor {
+	if c == nil {
+		return oldgloss.NoColor{}
+	}
+	if _, ok := c.(lipgloss.NoColor); ok {
+		return oldgloss.NoColor{}
+	}
+	r, g, b, _ := c.RGBA()
+	return oldgloss.Color(fmt.Sprintf("#%02x%02x%02x", r>>8, g>>8, b>>8))
 }
 
 func renderCenteredLoading(width, height int) string {
@@ -546,39 +926,15 @@ func trimTimes(values []time.Time, maxItems int) []time.Time {
 	return values[len(values)-maxItems:]
 }
 
-func minInt(values ...int) int {
-	if len(values) == 0 {
-		return 0
-	}
-	minValue := values[0]
-	for _, v := range values[1:] {
-		if v < minValue {
-			minValue = v
-		}
-	}
-	return minValue
-}
-
-func (d *Dashboard) seedRealtimeSeries() {
-	if len(d.realtimeTimes) > 0 {
-		return
-	}
-	maxPoints := d.chartContentWidth()
-	if maxPoints <= 0 {
-		return
-	}
-	interval := time.Duration(d.realtimeInterval) * time.Second
-	start := time.Now().Add(-interval * time.Duration(maxPoints-1))
-	for i := range maxPoints {
-		d.realtimeTimes = append(d.realtimeTimes, start.Add(interval*time.Duration(i)))
-		d.realtimeProcessed = append(d.realtimeProcessed, 0)
-		d.realtimeFailed = append(d.realtimeFailed, 0)
+func shortYLabelFormatter() func(int, float64) string {
+	return func(_ int, v float64) string {
+		return format.ShortNumber(int64(v + 0.5))
 	}
 }
 
-func shortYLabelFormatter() func(int, float64) string {
+func percentYLabelFormatter() func(int, float64) string {
 	return func(_ int, v float64) string {
-		return format.ShortNumber(int64(v + 0.5))
+		return formatPercent(v)
 	}
 }
 
@@ -0,0 +1,22 @@
+package views
+
+import (
+	"path/filepath"
+	"testing"
+)
+
+func TestCreateExportFile(t *testing.T) {
+	base := filepath.Join(t.TempDir(), "lazykiq-stats-20260101-20260107")
+	want := []string{base + ".csv", base + "-1.csv", base + "-2.csv"}
+
+	for _, wantPath := range want {
+		file, path, err := createExportFile(base, "csv")
+		if err != nil {
+			t.Fatalf("createExportFile() error = %v", err)
+		}
+		_ = file.Close()
+		if path != wantPath {
+			t.Fatalf("createExportFile() path = %q, want %q", path, wantPath)
+		}
+	}
+}
@@ -7,6 +7,7 @@ import (
 
 	"charm.land/bubbles/v2/key"
 	tea "charm.land/bubbletea/v2"
+	"github.com/kpumuk/lazykiq/internal/config"
 	"github.com/kpumuk/lazykiq/internal/sidekiq"
 	"github.com/kpumuk/lazykiq/internal/ui/components/filterinput"
 	"github.com/kpumuk/lazykiq/internal/ui/components/frame"
@@ -29,8 +30,6 @@ type deadDataMsg struct {
 // Dead shows dead/morgue jobs.
 type Dead struct {
 	client      *sidekiq.Client
-	width       int
-	height      int
 	styles      Styles
 	jobs        []*sidekiq.SortedEntry
 	table       table.Model
@@ -39,30 +38,36 @@ type Dead struct {
 	totalPages  int
 	totalSize   int64
 	filter      filterinput.Model
-
-	// Job detail state
-	showDetail bool
-	jobDetail  jobdetail.Model
+	columns     columnSet[*sidekiq.SortedEntry]
+	keys        JobListKeyMap
+	pending     pendingJob
+	splitList
 }
 
 // NewDead creates a new Dead view.
 func NewDead(client *sidekiq.Client) *Dead {
-	return &Dead{
+	columns := newColumnSet(deadJobColumns, sortedEntryRecord, deadDefaultColumns)
+	d := &Dead{
 		client:      client,
 		currentPage: 1,
 		totalPages:  1,
 		filter:      filterinput.New(),
+		columns:     columns,
+		keys:        DefaultJobListKeyMap(),
 		table: table.New(
-			table.WithColumns(deadJobColumns),
+			table.WithColumns(columns.tableColumns()),
 			table.WithEmptyMessage("No dead jobs"),
 		),
-		jobDetail: jobdetail.New(),
 	}
+	d.splitList = newSplitList(&d.table, &d.filter, &d.jobs, jobdetail.New(
+		jobdetail.WithActions(jobdetail.ActionEdit, jobdetail.ActionEditEnqueue),
+	))
+	return d
 }
 
 // fetchDataCmd fetches dead jobs data from Redis.
 func (d *Dead) fetchDataCmd() tea.Cmd {
-	return func() tea.Msg {
+	return ClientCmd(d.client, func() tea.Msg {
 		ctx := context.Background()
 
 		if d.filter.Query() != "" {
@@ -105,7 +110,7 @@ func (d *Dead) fetchDataCmd() tea.Cmd {
 			totalPages:  totalPages,
 			totalSize:   totalSize,
 		}
-	}
+	})
 }
 
 // Init implements View.
@@ -118,19 +123,58 @@ func (d *Dead) Init() tea.Cmd {
 
 // Update implements View.
 func (d *Dead) Update(msg tea.Msg) (View, tea.Cmd) {
+	if msg, ok := msg.(ShowJobMsg); ok {
+		d.showDetail = false
+		d.pending.request(msg.JID, &d.filter, &d.table)
+		d.currentPage = 1
+		return d, d.fetchDataCmd()
+	}
+
+	// In the split layout, mouse events over the detail pane focus it
+	if cmd, ok := d.updateSplitMouse(msg); ok {
+		return d, cmd
+	}
+
+	// Relative times are computed when building rows. They refresh even
+	// while the job is focused, as the list stays visible in the split layout.
+	if _, ok := msg.(RenderTickMsg); ok {
+		d.updateTableRows()
+		return d, nil
+	}
+
+	// Edits finish after the detail may have been closed
+	switch msg := msg.(type) {
+	case jobEditedMsg:
+		if d.detailEntry == nil || d.detailEntry.JobRecord != msg.original {
+			return d, nil
+		}
+		return d, saveSortedEntryCmd(d.client, sidekiq.DeadSet, d.detailEntry, msg)
+	case jobSavedMsg:
+		if d.handleJobSaved(msg) {
+			return d, d.fetchDataCmd()
+		}
+		d.updateTableRows()
+		return d, nil
+	}
+
 	// If showing detail, delegate to detail component
 	if d.showDetail {
 		switch msg := msg.(type) {
 		case tea.KeyMsg:
-			if msg.String() == "esc" {
+			if key.Matches(msg, d.jobDetail.KeyMap.Close) {
 				d.showDetail = false
 				return d, nil
 			}
+		case jobdetail.ActionMsg:
+			return d, editJobCmd(d.client, msg.Action, msg.Job)
 		}
-		d.jobDetail, _ = d.jobDetail.Update(msg)
-		return d, nil
+		var cmd tea.Cmd
+		d.jobDetail, cmd = d.jobDetail.Update(msg)
+		return d, cmd
 	}
 
+	defer d.syncDetail()
+
 	switch msg := msg.(type) {
 	case deadDataMsg:
 		d.jobs = msg.jobs
@@ -139,6 +183,9 @@ func (d *Dead) Update(msg tea.Msg) (View, tea.Cmd) {
 		d.totalSize = msg.totalSize
 		d.ready = true
 		d.updateTableRows()
+		if entry := d.pending.take(d.jobs); entry != nil {
+			d.openDetail(entry)
+		}
 		return d, nil
 
 	case RefreshMsg:
@@ -152,16 +199,26 @@ func (d *Dead) Update(msg tea.Msg) (View, tea.Cmd) {
 		}
 		return d, nil
 
+	case tea.MouseWheelMsg:
+		d.table, _ = d.table.Update(msg)
+		return d, nil
+
+	case tea.MouseClickMsg:
+		if msg.Button == tea.MouseLeft {
+			d.table.ClickRow(msg.Y - frameBorderHeight - filterHeight)
+		}
+		return d, nil
+
 	case tea.KeyMsg:
 		wasFocused := d.filter.Focused()
 		var cmd tea.Cmd
 		d.filter, cmd = d.filter.Update(msg)
-		if wasFocused || msg.String() == "/" || msg.String() == "esc" || msg.String() == "ctrl+u" {
+		if wasFocused || key.Matches(msg, d.filter.KeyMap.Focus, d.filter.KeyMap.Clear) {
 			return d, cmd
 		}
 
-		switch msg.String() {
-		case "alt+left", "[":
+		switch {
+		case key.Matches(msg, d.keys.PrevPage):
 			if d.filter.Query() != "" {
 				return d, nil
 			}
@@ -170,7 +227,7 @@ func (d *Dead) Update(msg tea.Msg) (View, tea.Cmd) {
 				return d, d.fetchDataCmd()
 			}
 			return d, nil
-		case "alt+right", "]":
+		case key.Matches(msg, d.keys.NextPage):
 			if d.filter.Query() != "" {
 				return d, nil
 			}
@@ -179,12 +236,8 @@ func (d *Dead) Update(msg tea.Msg) (View, tea.Cmd) {
 				return d, d.fetchDataCmd()
 			}
 			return d, nil
-		case "enter":
-			// Show detail for selected job
-			if idx := d.table.Cursor(); idx >= 0 && idx < len(d.jobs) {
-				d.jobDetail.SetJob(d.jobs[idx].JobRecord)
-				d.showDetail = true
-			}
+		case key.Matches(msg, d.keys.Open):
+			d.openSelected()
 			return d, nil
 		}
 
@@ -197,10 +250,11 @@ func (d *Dead) Update(msg tea.Msg) (View, tea.Cmd) {
 
 // View implements View.
 func (d *Dead) View() string {
-	if d.showDetail {
-		return d.renderJobDetail()
-	}
+	return d.view(d.styles, d.renderList)
+}
 
+// renderList renders the job list, or a message while it is loading or empty.
+func (d *Dead) renderList() string {
 	if !d.ready {
 		return d.renderMessage("Loading...")
 	}
@@ -217,7 +271,7 @@ func (d *Dead) renderMessage(msg string) string {
 		Title:  d.styles.Title,
 		Muted:  d.styles.Muted,
 		Border: d.styles.FocusBorder,
-	}, "Dead Jobs", msg, d.width, d.height)
+	}, "Dead Jobs", msg, d.listWidth(), d.height)
 }
 
 // Name implements View.
@@ -230,13 +284,17 @@ func (d *Dead) ShortHelp() []key.Binding {
 	return nil
 }
 
+// FullHelp implements View.
+func (d *Dead) FullHelp() []HelpSection {
+	if d.showDetail {
+		return jobDetailHelp(d.jobDetail)
+	}
+	return jobListHelp(d.keys, "Dead", d.filter, d.table)
+}
+
 // SetSize implements View.
 func (d *Dead) SetSize(width, height int) View {
-	d.width = width
-	d.height = height
-	d.updateTableSize()
-	// Update job detail size (full size, component handles its own borders)
-	d.jobDetail.SetSize(width, height)
+	d.setSize(width, height)
 	return d
 }
 
@@ -245,6 +303,24 @@ func (d *Dead) FilterFocused() bool {
 	return d.filter.Focused()
 }
 
+// SetColumns implements ColumnConfigurable.
+func (d *Dead) SetColumns(columns []config.Column) error {
+	if err := d.columns.configure(columns); err != nil {
+		return err
+	}
+	d.table.SetColumns(d.columns.tableColumns())
+	d.updateTableRows()
+	return nil
+}
+
+// SetKeyMaps implements KeyMapConfigurable.
+func (d *Dead) SetKeyMaps(keys KeyMaps) {
+	d.keys = keys.Jobs
+	d.table.KeyMap = keys.Table
+	d.filter.KeyMap = keys.Filter
+	d.jobDetail.KeyMap = keys.JobDetail
+}
+
 // SetStyles implements View.
 func (d *Dead) SetStyles(styles Styles) View {
 	d.styles = styles
@@ -276,28 +352,21 @@ func (d *Dead) SetStyles(styles Styles) View {
 		PanelTitle:      styles.Title,
 		FocusBorder:     styles.FocusBorder,
 		Muted:           styles.Muted,
+		Selected:        styles.TableSelected,
 	})
 	return d
 }
 
 // Table columns for dead job list.
-var deadJobColumns = []table.Column{
-	{Title: "Last Retry", Width: 12},
-	{Title: "Queue", Width: 15},
-	{Title: "Job", Width: 30},
-	{Title: "Arguments", Width: 40},
-	{Title: "Error", Width: 60},
+var deadJobColumns = []jobColumn[*sidekiq.SortedEntry]{
+	{name: "last_retry", title: "Last Retry", width: 12, value: func(job *sidekiq.SortedEntry) string {
+		// Format "last retry" as relative time
+		return format.Duration(time.Now().Unix() - job.At())
+	}},
 }
 
-// updateTableSize updates the table dimensions based on current view size.
-func (d *Dead) updateTableSize() {
-	// Calculate table height: total height - box borders
-	tableHeight := max(d.height-3, 3)
-	// Table width: view width - box borders - padding
-	tableWidth := d.width - 4
-	d.table.SetSize(tableWidth, tableHeight)
-	d.filter.SetWidth(tableWidth)
-}
+// deadDefaultColumns lists the columns shown unless overridden in the config file.
+var deadDefaultColumns = []string{"last_retry", "queue", "class", "args", "error"}
 
 // updateTableRows converts job data to table rows.
 func (d *Dead) updateTableRows() {
@@ -308,32 +377,11 @@ func (d *Dead) updateTableRows() {
 	}
 
 	rows := make([]table.Row, 0, len(d.jobs))
-	now := time.Now().Unix()
 	for _, job := range d.jobs {
-		// Format "last retry" as relative time
-		lastRetry := format.Duration(now - job.At())
-
-		// Format error
-		errorStr := ""
-		if job.HasError() {
-			errorStr = fmt.Sprintf("%s: %s", job.ErrorClass(), job.ErrorMessage())
-			// Truncate if too long
-			if len(errorStr) > 100 {
-				errorStr = errorStr[:97] + "..."
-			}
-		}
-
-		row := table.Row{
-			lastRetry,
-			job.Queue(),
-			job.DisplayClass(),
-			format.Args(job.DisplayArgs()),
-			errorStr,
-		}
-		rows = append(rows, row)
+		rows = append(rows, d.columns.row(job))
 	}
 	d.table.SetRows(rows)
-	d.updateTableSize()
+	d.resize()
 }
 
 // renderJobsBox renders the bordered box containing the jobs table.
@@ -363,14 +411,9 @@ func (d *Dead) renderJobsBox() string {
 		frame.WithMeta(meta),
 		frame.WithContent(content),
 		frame.WithPadding(1),
-		frame.WithSize(d.width, d.height),
+		frame.WithSize(d.listWidth(), d.height),
 		frame.WithMinHeight(5),
-		frame.WithFocused(true),
+		frame.WithFocused(!d.showDetail),
 	)
 	return box.View()
 }
-
-// renderJobDetail renders the job detail view.
-func (d *Dead) renderJobDetail() string {
-	return d.jobDetail.View()
-}
@@ -0,0 +1,141 @@
+package views
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/kpumuk/lazykiq/internal/sidekiq"
+	"github.com/kpumuk/lazykiq/internal/ui/components/jobdetail"
+	"github.com/kpumuk/lazykiq/internal/ui/editor"
+)
+
+// jobEditedMsg carries a job payload edited in the external editor.
+type jobEditedMsg struct {
+	action   jobdetail.Action
+	original *sidekiq.JobRecord
+	value    string
+	err      error
+}
+
+// jobSavedMsg reports the result of saving an edited job payload. original
+// identifies the edited job, as the list may have been refreshed and the
+// detail closed or moved to another job while it was being saved.
+type jobSavedMsg struct {
+	action   jobdetail.Action
+	original *sidekiq.JobRecord
+	job      *sidekiq.JobRecord
+	err      error
+}
+
+// errJobUnchanged is reported when the editor exits without modifying the payload.
+var errJobUnchanged = errors.New("no changes")
+
+// editJobCmd suspends the program, opens the job payload in the external editor,
+// and reports the validated result as a jobEditedMsg tagged with client.
+func editJobCmd(client *sidekiq.Client, action jobdetail.Action, job *sidekiq.JobRecord) tea.Cmd {
+	file, err := os.CreateTemp("", "lazykiq-job-*.json")
+	if err != nil {
+		return editFailedCmd(client, action, job, fmt.Errorf("create temp file: %w", err))
+	}
+	path := file.Name()
+
+	var pretty bytes.Buffer
+	if err := json.Indent(&pretty, []byte(job.Value()), "", "  "); err != nil {
+		pretty.Reset()
+		pretty.WriteString(job.Value())
+	}
+	pretty.WriteString("\n")
+
+	_, writeErr := file.Write(pretty.Bytes())
+	closeErr := file.Close()
+	if err := errors.Join(writeErr, closeErr); err != nil {
+		_ = os.Remove(path)
+		return editFailedCmd(client, action, job, fmt.Errorf("write temp file: %w", err))
+	}
+
+	return tea.ExecProcess(editor.Command(path, 0), func(err error) tea.Msg {
+		defer func() {
+			_ = os.Remove(path)
+		}()
+		return ClientMsg{Client: client, Msg: readEditedJob(action, job, path, err)}
+	})
+}
+
+// readEditedJob validates the payload the editor left in path.
+func readEditedJob(action jobdetail.Action, job *sidekiq.JobRecord, path string, err error) jobEditedMsg {
+	if err != nil {
+		return jobEditedMsg{action: action, original: job, err: fmt.Errorf("run editor: %w", err)}
+	}
+
+	data, err := os.ReadFile(path) //nolint:gosec // path is our own temp file
+	if err != nil {
+		return jobEditedMsg{action: action, original: job, err: fmt.Errorf("read temp file: %w", err)}
+	}
+
+	var compact bytes.Buffer
+	if err := json.Compact(&compact, data); err != nil {
+		return jobEditedMsg{action: action, original: job, err: fmt.Errorf("invalid JSON: %w", err)}
+	}
+	value := compact.String()
+	if err := sidekiq.ValidateJobPayload(value); err != nil {
+		return jobEditedMsg{action: action, original: job, err: err}
+	}
+	if value == job.Value() && action == jobdetail.ActionEdit {
+		return jobEditedMsg{action: action, original: job, err: errJobUnchanged}
+	}
+
+	return jobEditedMsg{action: action, original: job, value: value}
+}
+
+func editFailedCmd(client *sidekiq.Client, action jobdetail.Action, job *sidekiq.JobRecord, err error) tea.Cmd {
+	return ClientCmd(client, func() tea.Msg {
+		return jobEditedMsg{action: action, original: job, err: err}
+	})
+}
+
+// isEditedJob reports whether job is the one an edit started from. Entries
+// are rebuilt on every refresh, so they are compared by payload.
+func isEditedJob(job, original *sidekiq.JobRecord) bool {
+	return job != nil && original != nil && job.Value() == original.Value()
+}
+
+// editStatus returns the job detail status line for an edit result.
+func editStatus(action jobdetail.Action, err error) string {
+	switch {
+	case errors.Is(err, errJobUnchanged):
+		return "No changes"
+	case err != nil:
+		return "Edit failed: " + err.Error()
+	case action == jobdetail.ActionEditEnqueue:
+		return "Job enqueued"
+	default:
+		return "Job saved"
+	}
+}
+
+// saveSortedEntryCmd stores an edited payload back into a sorted set, or
+// moves it to its queue for ActionEditEnqueue.
+func saveSortedEntryCmd(client *sidekiq.Client, set sidekiq.SortedSet, entry *sidekiq.SortedEntry, msg jobEditedMsg) tea.Cmd {
+	return ClientCmd(client, func() tea.Msg {
+		if msg.err != nil {
+			return jobSavedMsg{action: msg.action, original: msg.original, err: msg.err}
+		}
+
+		ctx := context.Background()
+		var err error
+		if msg.action == jobdetail.ActionEditEnqueue {
+			err = client.EnqueueSortedEntry(ctx, set, entry, msg.value)
+		} else {
+			err = client.ReplaceSortedEntry(ctx, set, entry, msg.value)
+		}
+		if err != nil {
+			return jobSavedMsg{action: msg.action, original: msg.original, err: err}
+		}
+		return jobSavedMsg{action: msg.action, original: msg.original, job: sidekiq.NewJobRecord(msg.value, "")}
+	})
+}
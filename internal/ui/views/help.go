@@ -0,0 +1,46 @@
+package views
+
+import (
+	"slices"
+
+	"charm.land/bubbles/v2/key"
+	"github.com/kpumuk/lazykiq/internal/ui/components/filterinput"
+	"github.com/kpumuk/lazykiq/internal/ui/components/jobdetail"
+	"github.com/kpumuk/lazykiq/internal/ui/components/table"
+)
+
+// HelpSection groups keybindings under a title in the help overlay.
+type HelpSection struct {
+	Title    string
+	Bindings []key.Binding
+}
+
+// tableHelp returns the navigation bindings of a table.
+func tableHelp(t table.Model) HelpSection {
+	return HelpSection{Title: "Table", Bindings: slices.Concat(t.KeyMap.FullHelp()...)}
+}
+
+// jobDetailHelp returns the sections shown while a job detail is open.
+func jobDetailHelp(detail jobdetail.Model) []HelpSection {
+	return []HelpSection{
+		{
+			Title:    "Job details",
+			Bindings: detail.HelpBindings(),
+		},
+	}
+}
+
+// jobListHelp returns the sections of a paged, filterable job list.
+func jobListHelp(keys JobListKeyMap, title string, filter filterinput.Model, t table.Model) []HelpSection {
+	if filter.Focused() {
+		return []HelpSection{{Title: "Filter", Bindings: filter.HelpBindings()}}
+	}
+	return []HelpSection{
+		{
+			Title:    title,
+			Bindings: []key.Binding{keys.Open, keys.PrevPage, keys.NextPage},
+		},
+		{Title: "Filter", Bindings: filter.HelpBindings()},
+		tableHelp(t),
+	}
+}
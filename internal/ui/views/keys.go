@@ -0,0 +1,333 @@
+package views
+
+import (
+	"slices"
+
+	"charm.land/bubbles/v2/key"
+	"github.com/kpumuk/lazykiq/internal/ui/components/daterange"
+	"github.com/kpumuk/lazykiq/internal/ui/components/filterinput"
+	"github.com/kpumuk/lazykiq/internal/ui/components/jobdetail"
+	"github.com/kpumuk/lazykiq/internal/ui/components/table"
+)
+
+// KeyMaps holds the key maps of the views and of the components they embed.
+type KeyMaps struct {
+	Table     table.KeyMap
+	JobDetail jobdetail.KeyMap
+	Filter    filterinput.KeyMap
+	DateRange daterange.KeyMap
+	Dashboard DashboardKeyMap
+	Queues    QueuesKeyMap
+	Busy      BusyKeyMap
+	Jobs      JobListKeyMap
+	Redis     RedisKeyMap
+	Metrics   MetricsKeyMap
+	Batches   BatchesKeyMap
+}
+
+// DefaultKeyMaps returns the default key maps of every view.
+func DefaultKeyMaps() KeyMaps {
+	return KeyMaps{
+		Table:     table.DefaultKeyMap(),
+		JobDetail: jobdetail.DefaultKeyMap(),
+		Filter:    filterinput.DefaultKeyMap(),
+		DateRange: daterange.DefaultKeyMap(),
+		Dashboard: DefaultDashboardKeyMap(),
+		Queues:    DefaultQueuesKeyMap(),
+		Busy:      DefaultBusyKeyMap(),
+		Jobs:      DefaultJobListKeyMap(),
+		Redis:     DefaultRedisKeyMap(),
+		Metrics:   DefaultMetricsKeyMap(),
+		Batches:   DefaultBatchesKeyMap(),
+	}
+}
+
+// DashboardKeyMap defines keybindings for the Dashboard view.
+type DashboardKeyMap struct {
+	SwitchPane    key.Binding
+	Shorter       key.Binding
+	Longer        key.Binding
+	ScrollBack    key.Binding
+	ScrollForward key.Binding
+	Oldest        key.Binding
+	Live          key.Binding
+	PickRange     key.Binding
+	FailureRate   key.Binding
+	ExportCSV     key.Binding
+	ExportJSON    key.Binding
+}
+
+// DefaultDashboardKeyMap returns the default Dashboard keybindings.
+func DefaultDashboardKeyMap() DashboardKeyMap {
+	return DashboardKeyMap{
+		SwitchPane: key.NewBinding(
+			key.WithKeys("tab"),
+			key.WithHelp("tab", "switch pane"),
+		),
+		Shorter: key.NewBinding(
+			key.WithKeys("["),
+			key.WithHelp("[", "shorter interval or range"),
+		),
+		Longer: key.NewBinding(
+			key.WithKeys("]"),
+			key.WithHelp("]", "longer interval or range"),
+		),
+		ScrollBack: key.NewBinding(
+			key.WithKeys("left", "h"),
+			key.WithHelp("←/h", "scroll back through realtime history"),
+		),
+		ScrollForward: key.NewBinding(
+			key.WithKeys("right", "l"),
+			key.WithHelp("→/l", "scroll forward through realtime history"),
+		),
+		Oldest: key.NewBinding(
+			key.WithKeys("home"),
+			key.WithHelp("home", "oldest realtime history"),
+		),
+		Live: key.NewBinding(
+			key.WithKeys("end"),
+			key.WithHelp("end", "live realtime history"),
+		),
+		PickRange: key.NewBinding(
+			key.WithKeys("r"),
+			key.WithHelp("r", "pick history date range"),
+		),
+		FailureRate: key.NewBinding(
+			key.WithKeys("f"),
+			key.WithHelp("f", "toggle history failure rate"),
+		),
+		ExportCSV: key.NewBinding(
+			key.WithKeys("x"),
+			key.WithHelp("x", "export history as CSV"),
+		),
+		ExportJSON: key.NewBinding(
+			key.WithKeys("X"),
+			key.WithHelp("X", "export history as JSON"),
+		),
+	}
+}
+
+// QueuesKeyMap defines keybindings for the Queues view.
+type QueuesKeyMap struct {
+	Open key.Binding
+	// SelectQueue selects the queue at the position of the matched key.
+	SelectQueue key.Binding
+	PrevPage    key.Binding
+	NextPage    key.Binding
+	Chart       key.Binding
+	Coverage    key.Binding
+}
+
+// DefaultQueuesKeyMap returns the default Queues keybindings.
+func DefaultQueuesKeyMap() QueuesKeyMap {
+	return QueuesKeyMap{
+		Open: key.NewBinding(
+			key.WithKeys("enter"),
+			key.WithHelp("enter", "job details"),
+		),
+		SelectQueue: key.NewBinding(
+			key.WithKeys(ctrlDigitKeys...),
+			key.WithHelp("ctrl+1-9", "select queue"),
+		),
+		PrevPage: prevPageBinding(),
+		NextPage: nextPageBinding(),
+		Chart: key.NewBinding(
+			key.WithKeys("c"),
+			key.WithHelp("c", "toggle queue history chart"),
+		),
+		Coverage: key.NewBinding(
+			key.WithKeys("v"),
+			key.WithHelp("v", "toggle queue to process coverage"),
+		),
+	}
+}
+
+// BusyKeyMap defines keybindings for the Busy view.
+type BusyKeyMap struct {
+	Open    key.Binding
+	Summary key.Binding
+	// SelectProcess shows jobs of the process at the position of the
+	// matched key.
+	SelectProcess key.Binding
+	AllProcesses  key.Binding
+	ProcessInfo   key.Binding
+	CloseInfo     key.Binding
+	RemoveStale   key.Binding
+	SortByElapsed key.Binding
+}
+
+// DefaultBusyKeyMap returns the default Busy keybindings.
+func DefaultBusyKeyMap() BusyKeyMap {
+	return BusyKeyMap{
+		Open: key.NewBinding(
+			key.WithKeys("enter"),
+			key.WithHelp("enter", "job details"),
+		),
+		Summary: key.NewBinding(
+			key.WithKeys("a"),
+			key.WithHelp("a", "toggle summary by class and queue"),
+		),
+		SelectProcess: key.NewBinding(
+			key.WithKeys(ctrlDigitKeys...),
+			key.WithHelp("ctrl+1-9", "show jobs of a process"),
+		),
+		AllProcesses: key.NewBinding(
+			key.WithKeys("ctrl+0"),
+			key.WithHelp("ctrl+0", "show jobs of all processes"),
+		),
+		ProcessInfo: key.NewBinding(
+			key.WithKeys("i"),
+			key.WithHelp("i", "toggle process details"),
+		),
+		CloseInfo: key.NewBinding(
+			key.WithKeys("esc"),
+			key.WithHelp("esc", "hide process details"),
+		),
+		RemoveStale: key.NewBinding(
+			key.WithKeys("x"),
+			key.WithHelp("x", "remove stale processes"),
+		),
+		SortByElapsed: key.NewBinding(
+			key.WithKeys("s"),
+			key.WithHelp("s", "toggle sorting by elapsed time"),
+		),
+	}
+}
+
+// JobListKeyMap defines keybindings for the Retries, Scheduled, and Dead
+// job lists.
+type JobListKeyMap struct {
+	Open     key.Binding
+	PrevPage key.Binding
+	NextPage key.Binding
+}
+
+// DefaultJobListKeyMap returns the default job list keybindings.
+func DefaultJobListKeyMap() JobListKeyMap {
+	return JobListKeyMap{
+		Open: key.NewBinding(
+			key.WithKeys("enter"),
+			key.WithHelp("enter", "job details"),
+		),
+		PrevPage: prevPageBinding(),
+		NextPage: nextPageBinding(),
+	}
+}
+
+// RedisKeyMap defines keybindings for the Redis view.
+type RedisKeyMap struct {
+	NextPane key.Binding
+	PrevPane key.Binding
+	Rescan   key.Binding
+}
+
+// DefaultRedisKeyMap returns the default Redis keybindings.
+func DefaultRedisKeyMap() RedisKeyMap {
+	return RedisKeyMap{
+		NextPane: key.NewBinding(
+			key.WithKeys("tab"),
+			key.WithHelp("tab", "next of health, slowlog, and memory"),
+		),
+		PrevPane: key.NewBinding(
+			key.WithKeys("shift+tab"),
+			key.WithHelp("shift+tab", "previous of health, slowlog, and memory"),
+		),
+		Rescan: key.NewBinding(
+			key.WithKeys("r"),
+			key.WithHelp("r", "rescan memory usage"),
+		),
+	}
+}
+
+// MetricsKeyMap defines keybindings for the Metrics view.
+type MetricsKeyMap struct {
+	NextWindow key.Binding
+	PrevWindow key.Binding
+	Open       key.Binding
+	Back       key.Binding
+}
+
+// DefaultMetricsKeyMap returns the default Metrics keybindings.
+func DefaultMetricsKeyMap() MetricsKeyMap {
+	return MetricsKeyMap{
+		NextWindow: key.NewBinding(
+			key.WithKeys("w"),
+			key.WithHelp("w", "next time window"),
+		),
+		PrevWindow: key.NewBinding(
+			key.WithKeys("W"),
+			key.WithHelp("W", "previous time window"),
+		),
+		Open: key.NewBinding(
+			key.WithKeys("enter"),
+			key.WithHelp("enter", "show execution time histogram"),
+		),
+		Back: key.NewBinding(
+			key.WithKeys("esc"),
+			key.WithHelp("esc", "back to classes"),
+		),
+	}
+}
+
+// BatchesKeyMap defines keybindings for the Batches view. Open shows the
+// batch under the cursor, or finds the job under the cursor of a batch.
+type BatchesKeyMap struct {
+	Open     key.Binding
+	PrevPage key.Binding
+	NextPage key.Binding
+	Parent   key.Binding
+	Back     key.Binding
+}
+
+// DefaultBatchesKeyMap returns the default Batches keybindings.
+func DefaultBatchesKeyMap() BatchesKeyMap {
+	return BatchesKeyMap{
+		Open: key.NewBinding(
+			key.WithKeys("enter"),
+			key.WithHelp("enter", "show batch"),
+		),
+		PrevPage: prevPageBinding(),
+		NextPage: nextPageBinding(),
+		Parent: key.NewBinding(
+			key.WithKeys("p"),
+			key.WithHelp("p", "open parent batch"),
+		),
+		Back: key.NewBinding(
+			key.WithKeys("esc"),
+			key.WithHelp("esc", "back to batches"),
+		),
+	}
+}
+
+// ctrlDigitKeys selects the first nine rows of a list, in order.
+var ctrlDigitKeys = []string{"ctrl+1", "ctrl+2", "ctrl+3", "ctrl+4", "ctrl+5", "ctrl+6", "ctrl+7", "ctrl+8", "ctrl+9"}
+
+func prevPageBinding() key.Binding {
+	return key.NewBinding(
+		key.WithKeys("[", "alt+left"),
+		key.WithHelp("[", "previous page"),
+	)
+}
+
+func nextPageBinding() key.Binding {
+	return key.NewBinding(
+		key.WithKeys("]", "alt+right"),
+		key.WithHelp("]", "next page"),
+	)
+}
+
+// matchedIndex returns the position of the pressed key among the keys of
+// binding, or -1 when it does not match.
+func matchedIndex(binding key.Binding, pressed string) int {
+	if !binding.Enabled() {
+		return -1
+	}
+	return slices.Index(binding.Keys(), pressed)
+}
+
+// withHelpDesc returns binding with another help description, for keys
+// whose action depends on what the view shows.
+func withHelpDesc(binding key.Binding, desc string) key.Binding {
+	binding.SetHelp(binding.Help().Key, desc)
+	return binding
+}
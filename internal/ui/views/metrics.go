@@ -0,0 +1,433 @@
+package views
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"charm.land/bubbles/v2/key"
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+	"github.com/kpumuk/lazykiq/internal/sidekiq"
+	"github.com/kpumuk/lazykiq/internal/ui/components/frame"
+	"github.com/kpumuk/lazykiq/internal/ui/components/messagebox"
+	"github.com/kpumuk/lazykiq/internal/ui/components/table"
+	"github.com/kpumuk/lazykiq/internal/ui/format"
+)
+
+// histogramBarWidth is the width of the longest histogram bar.
+const histogramBarWidth = 40
+
+// metricsWindow is a selectable time window of the Metrics view.
+type metricsWindow struct {
+	label    string
+	duration time.Duration
+}
+
+// metricsWindows lists the windows cycled with w; Sidekiq keeps metrics
+// for 8 hours.
+var metricsWindows = []metricsWindow{
+	{label: "15m", duration: 15 * time.Minute},
+	{label: "30m", duration: 30 * time.Minute},
+	{label: "1h", duration: time.Hour},
+	{label: "2h", duration: 2 * time.Hour},
+	{label: "4h", duration: 4 * time.Hour},
+	{label: "8h", duration: sidekiq.MetricsRetention},
+}
+
+// defaultMetricsWindow is the index of the 1 hour window Sidekiq's web UI
+// defaults to.
+const defaultMetricsWindow = 2
+
+// metricsDataMsg carries job metrics from the fetch command to the view.
+type metricsDataMsg struct {
+	metrics []sidekiq.JobMetrics
+}
+
+// metricsHistogramMsg carries the execution time histogram of a class.
+type metricsHistogramMsg struct {
+	class   string
+	buckets []int64
+}
+
+var metricsColumns = []table.Column{
+	{Title: "Class", Width: 40},
+	{Title: "Processed", Width: 10},
+	{Title: "Failed", Width: 8},
+	{Title: "Failure", Width: 7},
+	{Title: "Total", Width: 10},
+	{Title: "Avg", Width: 10},
+}
+
+// Metrics shows execution metrics per job class recorded by Sidekiq 7+.
+type Metrics struct {
+	client *sidekiq.Client
+	width  int
+	height int
+	styles Styles
+
+	window  int
+	metrics []sidekiq.JobMetrics
+	ready   bool
+	table   table.Model
+
+	showHistogram  bool
+	histogramClass string
+	histogram      []int64
+	histogramReady bool
+
+	keys MetricsKeyMap
+}
+
+// NewMetrics creates a new Metrics view.
+func NewMetrics(client *sidekiq.Client) *Metrics {
+	return &Metrics{
+		client: client,
+		window: defaultMetricsWindow,
+		keys:   DefaultMetricsKeyMap(),
+		table: table.New(
+			table.WithColumns(metricsColumns),
+			table.WithEmptyMessage("No metrics (Sidekiq 7+ records them per job class)"),
+		),
+	}
+}
+
+// fetchDataCmd fetches job metrics over the selected window.
+func (m *Metrics) fetchDataCmd() tea.Cmd {
+	window := metricsWindows[m.window].duration
+	return ClientCmd(m.client, func() tea.Msg {
+		metrics, err := m.client.GetJobMetrics(context.Background(), window, time.Now())
+		if err != nil {
+			return ConnectionErrorMsg{Err: err}
+		}
+		return metricsDataMsg{metrics: metrics}
+	})
+}
+
+// fetchHistogramCmd fetches the execution time histogram of the shown class.
+func (m *Metrics) fetchHistogramCmd() tea.Cmd {
+	class := m.histogramClass
+	window := metricsWindows[m.window].duration
+	return ClientCmd(m.client, func() tea.Msg {
+		buckets, err := m.client.GetJobHistogram(context.Background(), class, window, time.Now())
+		if err != nil {
+			return ConnectionErrorMsg{Err: err}
+		}
+		return metricsHistogramMsg{class: class, buckets: buckets}
+	})
+}
+
+// fetchCmd fetches data for the visible pane.
+func (m *Metrics) fetchCmd() tea.Cmd {
+	if m.showHistogram {
+		return tea.Batch(m.fetchDataCmd(), m.fetchHistogramCmd())
+	}
+	return m.fetchDataCmd()
+}
+
+// Init implements View.
+func (m *Metrics) Init() tea.Cmd {
+	return m.fetchCmd()
+}
+
+// Update implements View.
+func (m *Metrics) Update(msg tea.Msg) (View, tea.Cmd) {
+	switch msg := msg.(type) {
+	case metricsDataMsg:
+		m.metrics = msg.metrics
+		m.ready = true
+		m.updateTableRows()
+		return m, nil
+
+	case metricsHistogramMsg:
+		if msg.class == m.histogramClass {
+			m.histogram = msg.buckets
+			m.histogramReady = true
+		}
+		return m, nil
+
+	case RefreshMsg:
+		return m, m.fetchCmd()
+
+	case tea.MouseWheelMsg:
+		if !m.showHistogram {
+			m.table, _ = m.table.Update(msg)
+		}
+		return m, nil
+
+	case tea.MouseClickMsg:
+		if msg.Button == tea.MouseLeft && !m.showHistogram {
+			m.table.ClickRow(msg.Y - frameBorderHeight)
+		}
+		return m, nil
+
+	case tea.KeyMsg:
+		switch {
+		case key.Matches(msg, m.keys.NextWindow):
+			m.window = (m.window + 1) % len(metricsWindows)
+			m.histogramReady = false
+			return m, m.fetchCmd()
+		case key.Matches(msg, m.keys.PrevWindow):
+			m.window = (m.window + len(metricsWindows) - 1) % len(metricsWindows)
+			m.histogramReady = false
+			return m, m.fetchCmd()
+		case key.Matches(msg, m.keys.Back):
+			m.showHistogram = false
+			return m, nil
+		case key.Matches(msg, m.keys.Open):
+			if m.showHistogram {
+				return m, nil
+			}
+			idx := m.table.Cursor()
+			if idx < 0 || idx >= len(m.metrics) {
+				return m, nil
+			}
+			m.showHistogram = true
+			m.histogramClass = m.metrics[idx].Class
+			m.histogram = nil
+			m.histogramReady = false
+			return m, m.fetchHistogramCmd()
+		}
+		if !m.showHistogram {
+			m.table, _ = m.table.Update(msg)
+		}
+		return m, nil
+	}
+
+	return m, nil
+}
+
+// View implements View.
+func (m *Metrics) View() string {
+	if !m.ready {
+		return messagebox.Render(messagebox.Styles{
+			Title:  m.styles.Title,
+			Muted:  m.styles.Muted,
+			Border: m.styles.FocusBorder,
+		}, "Metrics", "Loading...", m.width, m.height)
+	}
+	if m.showHistogram {
+		return m.renderHistogram()
+	}
+	return m.renderMetrics()
+}
+
+// Name implements View.
+func (m *Metrics) Name() string {
+	return "Metrics"
+}
+
+// ShortHelp implements View.
+func (m *Metrics) ShortHelp() []key.Binding {
+	return nil
+}
+
+// FullHelp implements View.
+func (m *Metrics) FullHelp() []HelpSection {
+	sections := []HelpSection{
+		{
+			Title: "Metrics",
+			Bindings: []key.Binding{
+				m.keys.NextWindow,
+				m.keys.PrevWindow,
+			},
+		},
+	}
+	if m.showHistogram {
+		sections[0].Bindings = append(sections[0].Bindings, m.keys.Back)
+		return sections
+	}
+	sections[0].Bindings = append(sections[0].Bindings, m.keys.Open)
+	return append(sections, tableHelp(m.table))
+}
+
+// SetSize implements View.
+func (m *Metrics) SetSize(width, height int) View {
+	m.width = width
+	m.height = height
+	m.table.SetSize(max(width-4, 1), max(height-2, 3))
+	return m
+}
+
+// SetKeyMaps implements KeyMapConfigurable.
+func (m *Metrics) SetKeyMaps(keys KeyMaps) {
+	m.keys = keys.Metrics
+	m.table.KeyMap = keys.Table
+}
+
+// SetStyles implements View.
+func (m *Metrics) SetStyles(styles Styles) View {
+	m.styles = styles
+	m.table.SetStyles(table.Styles{
+		Text:      styles.Text,
+		Muted:     styles.Muted,
+		Header:    styles.TableHeader,
+		Selected:  styles.TableSelected,
+		Separator: styles.TableSeparator,
+		Highlight: styles.ChartFailure,
+	})
+	return m
+}
+
+// updateTableRows fills the table, highlighting classes with failures.
+func (m *Metrics) updateTableRows() {
+	rows := make([]table.Row, len(m.metrics))
+	highlighted := make([]bool, len(m.metrics))
+	for i, metrics := range m.metrics {
+		failure := "0%"
+		if metrics.Processed > 0 {
+			failure = fmt.Sprintf("%.1f%%", float64(metrics.Failed)/float64(metrics.Processed)*100)
+		}
+		rows[i] = table.Row{
+			metrics.Class,
+			format.Number(metrics.Processed),
+			format.Number(metrics.Failed),
+			failure,
+			formatMilliseconds(float64(metrics.Milliseconds)),
+			formatMilliseconds(metrics.AvgMilliseconds()),
+		}
+		highlighted[i] = metrics.Failed > 0
+	}
+	m.table.SetRows(rows)
+	m.table.SetHighlighted(highlighted)
+}
+
+// formatMilliseconds formats execution times, keeping fractions of a second
+// for short times.
+func formatMilliseconds(ms float64) string {
+	if ms < 60_000 {
+		return formatRedisDuration(time.Duration(ms * float64(time.Millisecond)))
+	}
+	return format.Duration(int64(ms / 1000))
+}
+
+// renderMetrics renders the per-class metrics table.
+func (m *Metrics) renderMetrics() string {
+	var processed, failed int64
+	for _, metrics := range m.metrics {
+		processed += metrics.Processed
+		failed += metrics.Failed
+	}
+	sep := m.styles.Muted.Render(" • ")
+	meta := m.styles.MetricLabel.Render("window: ") + m.styles.MetricValue.Render(metricsWindows[m.window].label) +
+		sep + m.styles.MetricLabel.Render("processed: ") + m.styles.MetricValue.Render(format.Number(processed)) +
+		sep + m.styles.MetricLabel.Render("failed: ") + m.styles.MetricValue.Render(format.Number(failed))
+
+	return frame.New(
+		frame.WithStyles(m.frameStyles()),
+		frame.WithTitle("Job Metrics"),
+		frame.WithTitlePadding(0),
+		frame.WithMeta(meta),
+		frame.WithContent(m.table.View()),
+		frame.WithPadding(1),
+		frame.WithSize(m.width, m.height),
+		frame.WithMinHeight(5),
+		frame.WithFocused(true),
+	).View()
+}
+
+// renderHistogram renders the execution time histogram of the selected
+// class with bars scaled to the busiest bucket.
+func (m *Metrics) renderHistogram() string {
+	sep := m.styles.Muted.Render(" • ")
+	meta := m.styles.MetricLabel.Render("window: ") + m.styles.MetricValue.Render(metricsWindows[m.window].label)
+
+	var content string
+	switch {
+	case !m.histogramReady:
+		content = m.styles.Muted.Render("Loading...")
+	case sidekiq.HistogramPercentile(m.histogram, 0) < 0:
+		content = m.styles.Muted.Render("No executions recorded in this window")
+	default:
+		var total int64
+		for _, count := range m.histogram {
+			total += count
+		}
+		meta += sep + m.styles.MetricLabel.Render("executions: ") + m.styles.MetricValue.Render(format.Number(total))
+		for _, p := range []float64{50, 95, 99} {
+			bucket := sidekiq.HistogramPercentile(m.histogram, p)
+			meta += sep + m.styles.MetricLabel.Render(fmt.Sprintf("p%.0f: ", p)) +
+				m.styles.MetricValue.Render(histogramLabel(bucket))
+		}
+		content = lipgloss.NewStyle().MaxWidth(max(m.width-4, 1)).Render(strings.Join(m.histogramLines(total), "\n"))
+	}
+
+	return frame.New(
+		frame.WithStyles(m.frameStyles()),
+		frame.WithTitle("Execution Time: "+m.histogramClass),
+		frame.WithTitlePadding(0),
+		frame.WithMeta(meta),
+		frame.WithContent(content),
+		frame.WithPadding(1),
+		frame.WithSize(m.width, m.height),
+		frame.WithMinHeight(5),
+		frame.WithFocused(true),
+	).View()
+}
+
+// histogramLines renders a bar per bucket between the fastest and the
+// slowest recorded executions. Empty buckets are left out when they do not
+// fit the view.
+func (m *Metrics) histogramLines(total int64) []string {
+	first := sidekiq.HistogramPercentile(m.histogram, 0)
+	last := sidekiq.HistogramPercentile(m.histogram, 100)
+	var (
+		peak    int64
+		buckets []int
+	)
+	for i := first; i <= last; i++ {
+		peak = max(peak, m.histogram[i])
+		buckets = append(buckets, i)
+	}
+	if len(buckets) > m.height-2 {
+		buckets = slices.DeleteFunc(buckets, func(i int) bool { return m.histogram[i] == 0 })
+	}
+
+	labelWidth := 0
+	for _, i := range buckets {
+		labelWidth = max(labelWidth, lipgloss.Width(histogramLabel(i)))
+	}
+	lines := make([]string, 0, len(buckets))
+	for _, i := range buckets {
+		count := m.histogram[i]
+		filled := int(count * histogramBarWidth / peak)
+		if count > 0 {
+			filled = max(filled, 1)
+		}
+		bar := m.styles.ChartSuccess.Render(strings.Repeat("█", filled)) +
+			m.styles.Muted.Render(strings.Repeat("░", histogramBarWidth-filled))
+		label := histogramLabel(i)
+		label = strings.Repeat(" ", labelWidth-lipgloss.Width(label)) + label
+		lines = append(lines, m.styles.Text.Render(label)+"  "+bar+"  "+
+			m.styles.MetricValue.Render(format.Number(count))+
+			m.styles.Muted.Render(fmt.Sprintf(" (%.1f%%)", float64(count)/float64(total)*100)))
+	}
+	return lines
+}
+
+// histogramLabel describes the execution times counted in a bucket.
+func histogramLabel(bucket int) string {
+	if bucket < 0 || bucket >= len(sidekiq.HistogramLabels) {
+		return "n/a"
+	}
+	if bucket == len(sidekiq.HistogramLabels)-1 {
+		return "≥ " + sidekiq.HistogramLabels[bucket-1]
+	}
+	return "< " + sidekiq.HistogramLabels[bucket]
+}
+
+// frameStyles returns the styles of the view frame.
+func (m *Metrics) frameStyles() frame.Styles {
+	return frame.Styles{
+		Focused: frame.StyleState{
+			Title:  m.styles.Title,
+			Border: m.styles.FocusBorder,
+		},
+		Blurred: frame.StyleState{
+			Title:  m.styles.Title,
+			Border: m.styles.BorderStyle,
+		},
+	}
+}
@@ -5,14 +5,18 @@ import (
 	"encoding/json"
 	"fmt"
 	"strings"
+	"time"
 
 	"charm.land/bubbles/v2/key"
 	tea "charm.land/bubbletea/v2"
 	"charm.land/lipgloss/v2"
+	"github.com/kpumuk/lazykiq/internal/config"
 	"github.com/kpumuk/lazykiq/internal/sidekiq"
+	"github.com/kpumuk/lazykiq/internal/ui/clipboard"
 	"github.com/kpumuk/lazykiq/internal/ui/components/frame"
 	"github.com/kpumuk/lazykiq/internal/ui/components/jobdetail"
 	"github.com/kpumuk/lazykiq/internal/ui/components/messagebox"
+	"github.com/kpumuk/lazykiq/internal/ui/components/sparkline"
 	"github.com/kpumuk/lazykiq/internal/ui/components/table"
 	"github.com/kpumuk/lazykiq/internal/ui/format"
 )
@@ -26,15 +30,41 @@ type QueueInfo struct {
 
 // queuesDataMsg carries queues data internally.
 type queuesDataMsg struct {
+	fetchedAt     time.Time
 	queues        []*QueueInfo
 	jobs          []*sidekiq.PositionedEntry
 	currentPage   int
 	totalPages    int
 	selectedQueue int
+	processes     []sidekiq.Process // only fetched for the coverage map
+	serverTime    time.Time         // Redis time of the processes fetch
+	// withProcesses reports whether processes were fetched, as the coverage
+	// map may be toggled while a fetch is in flight.
+	withProcesses bool
 }
 
 const queuesPageSize = 25
 
+const (
+	// queueHistoryLimit caps the samples kept per queue
+	// (10 minutes at the 5-second refresh).
+	queueHistoryLimit = 120
+	// queueSampleMinInterval skips samples from fetches triggered by
+	// navigation right after a refresh, keeping samples evenly spaced.
+	queueSampleMinInterval = 2 * time.Second
+	// queueSparklineWidth is the maximum width of each queue list sparkline.
+	queueSparklineWidth = 20
+	// queueChartHeight is the height of the selected queue's history chart.
+	queueChartHeight = 10
+)
+
+// queueSample is a point-in-time queue size and latency.
+type queueSample struct {
+	at      time.Time
+	size    int64
+	latency float64
+}
+
 // Queues shows the list of Sidekiq queues.
 type Queues struct {
 	client        *sidekiq.Client
@@ -48,30 +78,53 @@ type Queues struct {
 	currentPage   int
 	totalPages    int
 	selectedQueue int
+	pendingQueue  string
+	columns       columnSet[*sidekiq.PositionedEntry]
+	history       map[string][]queueSample
+	showChart     bool
+	fetchedAt     time.Time
+	showCoverage  bool
+	processes     []sidekiq.Process
+	processesAt   time.Time // Redis time the processes were fetched at
+	coverage      []sidekiq.QueueCoverage
+	coverageTable table.Model
+	keys          QueuesKeyMap
 
 	// Job detail state
-	showDetail bool
-	jobDetail  jobdetail.Model
+	showDetail  bool
+	jobDetail   jobdetail.Model
+	detailEntry *sidekiq.PositionedEntry
 }
 
 // NewQueues creates a new Queues view.
 func NewQueues(client *sidekiq.Client) *Queues {
+	columns := newColumnSet(queueJobColumns, positionedEntryRecord, queueDefaultColumns)
 	return &Queues{
 		client:        client,
 		currentPage:   1,
 		totalPages:    1,
 		selectedQueue: 0,
+		columns:       columns,
+		history:       make(map[string][]queueSample),
+		keys:          DefaultQueuesKeyMap(),
 		table: table.New(
-			table.WithColumns(queueJobColumns),
+			table.WithColumns(columns.tableColumns()),
 			table.WithEmptyMessage("No jobs in queue"),
 		),
-		jobDetail: jobdetail.New(),
+		coverageTable: table.New(
+			table.WithColumns(coverageColumns),
+			table.WithEmptyMessage("No queues"),
+		),
+		jobDetail: jobdetail.New(
+			jobdetail.WithActions(jobdetail.ActionEdit),
+		),
 	}
 }
 
 // fetchDataCmd fetches queues data from Redis.
 func (q *Queues) fetchDataCmd() tea.Cmd {
-	return func() tea.Msg {
+	withProcesses := q.showCoverage
+	return ClientCmd(q.client, func() tea.Msg {
 		ctx := context.Background()
 
 		queues, err := q.client.GetQueues(ctx)
@@ -79,6 +132,19 @@ func (q *Queues) fetchDataCmd() tea.Cmd {
 			return ConnectionErrorMsg{Err: err}
 		}
 
+		var processes []sidekiq.Process
+		var serverTime time.Time
+		if withProcesses {
+			serverTime, err = q.client.ServerTime(ctx)
+			if err != nil {
+				return ConnectionErrorMsg{Err: err}
+			}
+			processes, err = q.client.GetProcesses(ctx)
+			if err != nil {
+				return ConnectionErrorMsg{Err: err}
+			}
+		}
+
 		queueInfos := make([]*QueueInfo, len(queues))
 		for i, queue := range queues {
 			size, _ := queue.Size(ctx)
@@ -95,6 +161,14 @@ func (q *Queues) fetchDataCmd() tea.Cmd {
 		currentPage := q.currentPage
 		totalPages := 1
 		selectedQueue := q.selectedQueue
+		if name := q.pendingQueue; name != "" {
+			for i, queue := range queues {
+				if queue.Name() == name {
+					selectedQueue = i
+					break
+				}
+			}
+		}
 
 		if selectedQueue >= len(queues) {
 			selectedQueue = 0
@@ -117,13 +191,17 @@ func (q *Queues) fetchDataCmd() tea.Cmd {
 		}
 
 		return queuesDataMsg{
+			fetchedAt:     time.Now(),
 			queues:        queueInfos,
 			jobs:          jobs,
 			currentPage:   currentPage,
 			totalPages:    totalPages,
 			selectedQueue: selectedQueue,
+			processes:     processes,
+			serverTime:    serverTime,
+			withProcesses: withProcesses,
 		}
-	}
+	})
 }
 
 // Init implements View.
@@ -136,17 +214,39 @@ func (q *Queues) Init() tea.Cmd {
 
 // Update implements View.
 func (q *Queues) Update(msg tea.Msg) (View, tea.Cmd) {
+	if msg, ok := msg.(SelectQueueMsg); ok {
+		q.showDetail = false
+		q.pendingQueue = msg.Name
+		q.currentPage = 1
+		return q, q.fetchDataCmd()
+	}
+
+	// Edits finish after the detail may have been closed
+	switch msg := msg.(type) {
+	case jobEditedMsg:
+		if q.detailEntry == nil || q.detailEntry.JobRecord != msg.original {
+			return q, nil
+		}
+		return q, q.saveEditedJobCmd(q.detailEntry, msg)
+	case jobSavedMsg:
+		q.handleJobSaved(msg)
+		return q, nil
+	}
+
 	// If showing detail, delegate to detail component
 	if q.showDetail {
 		switch msg := msg.(type) {
 		case tea.KeyMsg:
-			if msg.String() == "esc" {
+			if key.Matches(msg, q.jobDetail.KeyMap.Close) {
 				q.showDetail = false
 				return q, nil
 			}
+		case jobdetail.ActionMsg:
+			return q, editJobCmd(q.client, msg.Action, msg.Job)
 		}
-		q.jobDetail, _ = q.jobDetail.Update(msg)
-		return q, nil
+		var cmd tea.Cmd
+		q.jobDetail, cmd = q.jobDetail.Update(msg)
+		return q, cmd
 	}
 
 	switch msg := msg.(type) {
@@ -156,45 +256,104 @@ func (q *Queues) Update(msg tea.Msg) (View, tea.Cmd) {
 		q.currentPage = msg.currentPage
 		q.totalPages = msg.totalPages
 		q.selectedQueue = msg.selectedQueue
+		q.pendingQueue = ""
 		q.ready = true
+		q.fetchedAt = msg.fetchedAt
+		q.recordHistory(msg.fetchedAt)
 		q.updateTableRows()
+		if msg.withProcesses {
+			q.processes = msg.processes
+			q.processesAt = msg.serverTime
+			q.updateCoverageRows()
+		}
 		return q, nil
 
 	case RefreshMsg:
 		return q, q.fetchDataCmd()
 
+	case RenderTickMsg:
+		// Latencies are extrapolated from the last fetch when rendering
+		return q, nil
+
+	case tea.MouseWheelMsg:
+		if q.showCoverage {
+			q.coverageTable, _ = q.coverageTable.Update(msg)
+		} else {
+			q.table, _ = q.table.Update(msg)
+		}
+		return q, nil
+
+	case tea.MouseClickMsg:
+		if msg.Button != tea.MouseLeft {
+			return q, nil
+		}
+		// Queue list lines come first, then the framed jobs table
+		if msg.Y < len(q.queues) {
+			if q.selectedQueue != msg.Y {
+				q.selectedQueue = msg.Y
+				q.currentPage = 1
+				return q, q.fetchDataCmd()
+			}
+			return q, nil
+		}
+		y := msg.Y - len(q.queues) - q.chartHeight() - frameBorderHeight
+		if q.showCoverage {
+			q.coverageTable.ClickRow(y)
+		} else {
+			q.table.ClickRow(y)
+		}
+		return q, nil
+
 	case tea.KeyMsg:
-		switch msg.String() {
-		case "ctrl+1", "ctrl+2", "ctrl+3", "ctrl+4", "ctrl+5", "ctrl+6", "ctrl+7", "ctrl+8", "ctrl+9":
-			idx := int(msg.String()[5] - '1')
+		switch {
+		case key.Matches(msg, q.keys.SelectQueue):
+			idx := matchedIndex(q.keys.SelectQueue, msg.String())
 			if idx >= 0 && idx < len(q.queues) && q.selectedQueue != idx {
 				q.selectedQueue = idx
 				q.currentPage = 1
 				return q, q.fetchDataCmd()
 			}
 			return q, nil
-		case "alt+left", "[":
+		case key.Matches(msg, q.keys.PrevPage):
 			if q.currentPage > 1 {
 				q.currentPage--
 				return q, q.fetchDataCmd()
 			}
 			return q, nil
-		case "alt+right", "]":
+		case key.Matches(msg, q.keys.NextPage):
 			if q.currentPage < q.totalPages {
 				q.currentPage++
 				return q, q.fetchDataCmd()
 			}
 			return q, nil
-		case "enter":
+		case key.Matches(msg, q.keys.Chart):
+			q.showChart = !q.showChart
+			q.updateTableSize()
+			return q, nil
+		case key.Matches(msg, q.keys.Coverage):
+			q.showCoverage = !q.showCoverage
+			if q.showCoverage {
+				return q, q.fetchDataCmd()
+			}
+			return q, nil
+		case key.Matches(msg, q.keys.Open):
+			if q.showCoverage {
+				return q, q.selectCoverageQueue()
+			}
 			// Show detail for selected job
 			if idx := q.table.Cursor(); idx >= 0 && idx < len(q.jobs) {
-				q.jobDetail.SetJob(q.jobs[idx].JobRecord)
+				q.detailEntry = q.jobs[idx]
+				q.jobDetail.SetJob(q.detailEntry.JobRecord)
 				q.showDetail = true
 			}
 			return q, nil
 		}
 
-		q.table, _ = q.table.Update(msg)
+		if q.showCoverage {
+			q.coverageTable, _ = q.coverageTable.Update(msg)
+		} else {
+			q.table, _ = q.table.Update(msg)
+		}
 		return q, nil
 	}
 
@@ -215,7 +374,14 @@ func (q *Queues) View() string {
 		return q.renderMessage("No queues")
 	}
 
-	return lipgloss.JoinVertical(lipgloss.Left, q.renderQueueList(), q.renderJobsBox())
+	box := q.renderJobsBox()
+	if q.showCoverage {
+		box = q.renderCoverageBox()
+	}
+	if q.chartHeight() > 0 {
+		return lipgloss.JoinVertical(lipgloss.Left, q.renderQueueList(), q.renderChartBox(), box)
+	}
+	return lipgloss.JoinVertical(lipgloss.Left, q.renderQueueList(), box)
 }
 
 // Name implements View.
@@ -228,6 +394,40 @@ func (q *Queues) ShortHelp() []key.Binding {
 	return nil
 }
 
+// FullHelp implements View.
+func (q *Queues) FullHelp() []HelpSection {
+	if q.showDetail {
+		return jobDetailHelp(q.jobDetail)
+	}
+	if q.showCoverage {
+		return []HelpSection{
+			{
+				Title: "Queue Coverage",
+				Bindings: []key.Binding{
+					withHelpDesc(q.keys.Open, "show jobs of the queue"),
+					withHelpDesc(q.keys.Coverage, "back to jobs"),
+					q.keys.Chart,
+				},
+			},
+			tableHelp(q.coverageTable),
+		}
+	}
+	return []HelpSection{
+		{
+			Title: "Queues",
+			Bindings: []key.Binding{
+				q.keys.Open,
+				q.keys.SelectQueue,
+				q.keys.Chart,
+				q.keys.Coverage,
+				q.keys.PrevPage,
+				q.keys.NextPage,
+			},
+		},
+		tableHelp(q.table),
+	}
+}
+
 // SetSize implements View.
 func (q *Queues) SetSize(width, height int) View {
 	q.width = width
@@ -238,6 +438,29 @@ func (q *Queues) SetSize(width, height int) View {
 	return q
 }
 
+// SetColumns implements ColumnConfigurable.
+func (q *Queues) SetColumns(columns []config.Column) error {
+	if err := q.columns.configure(columns); err != nil {
+		return err
+	}
+	q.table.SetColumns(q.columns.tableColumns())
+	q.updateTableRows()
+	return nil
+}
+
+// SetClipboardMode implements ClipboardConfigurable.
+func (q *Queues) SetClipboardMode(mode clipboard.Mode) {
+	q.jobDetail.SetClipboardMode(mode)
+}
+
+// SetKeyMaps implements KeyMapConfigurable.
+func (q *Queues) SetKeyMaps(keys KeyMaps) {
+	q.keys = keys.Queues
+	q.table.KeyMap = keys.Table
+	q.coverageTable.KeyMap = keys.Table
+	q.jobDetail.KeyMap = keys.JobDetail
+}
+
 // SetStyles implements View.
 func (q *Queues) SetStyles(styles Styles) View {
 	q.styles = styles
@@ -248,6 +471,14 @@ func (q *Queues) SetStyles(styles Styles) View {
 		Selected:  styles.TableSelected,
 		Separator: styles.TableSeparator,
 	})
+	q.coverageTable.SetStyles(table.Styles{
+		Text:      styles.Text,
+		Muted:     styles.Muted,
+		Header:    styles.TableHeader,
+		Selected:  styles.TableSelected,
+		Separator: styles.TableSeparator,
+		Highlight: styles.ChartFailure,
+	})
 	q.jobDetail.SetStyles(jobdetail.Styles{
 		Title:           styles.Title,
 		Label:           styles.Muted,
@@ -263,6 +494,7 @@ func (q *Queues) SetStyles(styles Styles) View {
 		PanelTitle:      styles.Title,
 		FocusBorder:     styles.FocusBorder,
 		Muted:           styles.Muted,
+		Selected:        styles.TableSelected,
 	})
 	return q
 }
@@ -285,7 +517,7 @@ func (q *Queues) renderQueueList() string {
 		if len(sizeStr) > maxSizeLen {
 			maxSizeLen = len(sizeStr)
 		}
-		latencyStr := formatLatency(queue.Latency)
+		latencyStr := formatLatency(q.liveLatency(queue))
 		if len(latencyStr) > maxLatencyLen {
 			maxLatencyLen = len(latencyStr)
 		}
@@ -307,15 +539,136 @@ func (q *Queues) renderQueueList() string {
 
 		// Size and latency (right-aligned)
 		sizeStr := fmt.Sprintf("%*d", maxSizeLen, queue.Size)
-		latencyStr := fmt.Sprintf("%*s", maxLatencyLen, formatLatency(queue.Latency))
+		latencyStr := fmt.Sprintf("%*s", maxLatencyLen, formatLatency(q.liveLatency(queue)))
 		stats := q.styles.Muted.Render(fmt.Sprintf("  %s  %s", sizeStr, latencyStr))
 
-		lines = append(lines, hotkey+name+stats)
+		line := hotkey + name + stats
+		if sparkWidth := q.sparklineWidth(lipgloss.Width(line)); sparkWidth > 0 {
+			sizes, latencies := q.historySeries(queue.Name)
+			line += "  " + q.styles.ChartSuccess.Render(sparkline.Render(sizes, sparkWidth)) +
+				"  " + q.styles.ChartFailure.Render(sparkline.Render(latencies, sparkWidth))
+		}
+		lines = append(lines, line)
 	}
 
 	return q.styles.BoxPadding.Render(strings.Join(lines, "\n"))
 }
 
+// liveLatency returns the queue latency as of now. The oldest job keeps
+// waiting until a worker picks it up, so a non-empty queue ages by the time
+// since the last fetch.
+func (q *Queues) liveLatency(queue *QueueInfo) float64 {
+	if queue.Size == 0 || queue.Latency <= 0 || q.fetchedAt.IsZero() {
+		return queue.Latency
+	}
+	return queue.Latency + time.Since(q.fetchedAt).Seconds()
+}
+
+// recordHistory appends a size and latency sample for every queue and
+// drops the history of queues that no longer exist.
+func (q *Queues) recordHistory(at time.Time) {
+	seen := make(map[string]bool, len(q.queues))
+	for _, queue := range q.queues {
+		seen[queue.Name] = true
+		samples := q.history[queue.Name]
+		if n := len(samples); n > 0 && at.Sub(samples[n-1].at) < queueSampleMinInterval {
+			continue
+		}
+		samples = append(samples, queueSample{at: at, size: queue.Size, latency: queue.Latency})
+		if len(samples) > queueHistoryLimit {
+			samples = samples[len(samples)-queueHistoryLimit:]
+		}
+		q.history[queue.Name] = samples
+	}
+	for name := range q.history {
+		if !seen[name] {
+			delete(q.history, name)
+		}
+	}
+}
+
+// historySeries returns the recorded sizes and latencies of a queue.
+func (q *Queues) historySeries(name string) ([]float64, []float64) {
+	samples := q.history[name]
+	sizes := make([]float64, len(samples))
+	latencies := make([]float64, len(samples))
+	for i, sample := range samples {
+		sizes[i] = float64(sample.size)
+		latencies[i] = sample.latency
+	}
+	return sizes, latencies
+}
+
+// sparklineWidth returns the width of each queue list sparkline given the
+// width of the rest of the line, or 0 when they do not fit.
+func (q *Queues) sparklineWidth(lineWidth int) int {
+	padding := q.styles.BoxPadding.GetHorizontalPadding()
+	available := (q.width - padding - lineWidth - 4) / 2 // two sparklines, two gaps
+	if available < 5 {
+		return 0
+	}
+	return min(available, queueSparklineWidth)
+}
+
+// chartHeight returns the height of the history chart, or 0 when hidden or
+// the view is too short to fit it above the jobs table.
+func (q *Queues) chartHeight() int {
+	if !q.showChart || q.height-len(q.queues)-queueChartHeight < 8 {
+		return 0
+	}
+	return queueChartHeight
+}
+
+// renderChartBox renders size and latency history charts for the selected queue.
+func (q *Queues) renderChartBox() string {
+	queueName := ""
+	if q.selectedQueue < len(q.queues) {
+		queueName = q.queues[q.selectedQueue].Name
+	}
+	samples := q.history[queueName]
+	times := make([]time.Time, len(samples))
+	for i, sample := range samples {
+		times[i] = sample.at
+	}
+	sizes, latencies := q.historySeries(queueName)
+
+	height := q.chartHeight()
+	contentWidth := max(q.width-4, 2)
+	contentHeight := max(height-2, 1)
+	sizeWidth := contentWidth / 2
+	latencyWidth := contentWidth - sizeWidth - 1
+
+	sizeChart := renderLineChart(q.styles, sizeWidth, contentHeight, times, []chartSeries{
+		{name: "size", values: sizes, style: oldStyle(q.styles.ChartSuccess)},
+	}, realtimeTimeLabelFormatter(), shortYLabelFormatter())
+	latencyChart := renderLineChart(q.styles, latencyWidth, contentHeight, times, []chartSeries{
+		{name: "latency", values: latencies, style: oldStyle(q.styles.ChartFailure)},
+	}, realtimeTimeLabelFormatter(), func(_ int, v float64) string {
+		return formatLatency(v)
+	})
+
+	legend := q.styles.ChartSuccess.Render("■ size") + "  " + q.styles.ChartFailure.Render("■ latency")
+
+	return frame.New(
+		frame.WithStyles(frame.Styles{
+			Focused: frame.StyleState{
+				Title:  q.styles.Title,
+				Border: q.styles.BorderStyle,
+			},
+			Blurred: frame.StyleState{
+				Title:  q.styles.Title,
+				Border: q.styles.BorderStyle,
+			},
+		}),
+		frame.WithTitle("History of "+queueName),
+		frame.WithTitlePadding(0),
+		frame.WithMeta(legend),
+		frame.WithContent(lipgloss.JoinHorizontal(lipgloss.Top, sizeChart, " ", latencyChart)),
+		frame.WithPadding(1),
+		frame.WithSize(q.width, height),
+	).View()
+}
+
 // formatLatency formats latency in seconds as a readable string.
 func formatLatency(seconds float64) string {
 	if seconds < 1 {
@@ -325,34 +678,34 @@ func formatLatency(seconds float64) string {
 }
 
 // Table columns for queue job list.
-var queueJobColumns = []table.Column{
-	{Title: "#", Width: 6},
-	{Title: "Job", Width: 30},
-	{Title: "Arguments", Width: 60},
-	{Title: "Context", Width: 40},
+var queueJobColumns = []jobColumn[*sidekiq.PositionedEntry]{
+	{name: "position", title: "#", width: 6, value: func(job *sidekiq.PositionedEntry) string {
+		return fmt.Sprintf("%d", job.Position)
+	}},
+	{name: "args", title: "Arguments", width: 60, value: func(job *sidekiq.PositionedEntry) string {
+		return format.Args(job.DisplayArgs())
+	}},
 }
 
+// queueDefaultColumns lists the columns shown unless overridden in the config file.
+var queueDefaultColumns = []string{"position", "class", "args", "context"}
+
 // updateTableSize updates the table dimensions based on current view size.
 func (q *Queues) updateTableSize() {
 	// Calculate table height: total height - queue list - box borders
 	queueListHeight := len(q.queues)
-	tableHeight := max(q.height-queueListHeight-2, 3)
+	tableHeight := max(q.height-queueListHeight-q.chartHeight()-2, 3)
 	// Table width: view width - box borders - padding
 	tableWidth := q.width - 4
 	q.table.SetSize(tableWidth, tableHeight)
+	q.coverageTable.SetSize(tableWidth, tableHeight)
 }
 
 // updateTableRows converts job data to table rows.
 func (q *Queues) updateTableRows() {
 	rows := make([]table.Row, 0, len(q.jobs))
 	for _, job := range q.jobs {
-		row := table.Row{
-			fmt.Sprintf("%d", job.Position),
-			job.DisplayClass(),
-			format.Args(job.DisplayArgs()),
-			formatContext(job.Context()),
-		}
-		rows = append(rows, row)
+		rows = append(rows, q.columns.row(job))
 	}
 	q.table.SetRows(rows)
 	q.updateTableSize()
@@ -389,7 +742,7 @@ func (q *Queues) renderJobsBox() string {
 
 	// Calculate box height (account for queue list above)
 	queueListHeight := len(q.queues)
-	boxHeight := q.height - queueListHeight
+	boxHeight := q.height - queueListHeight - q.chartHeight()
 
 	// Get table content
 	content := q.table.View()
@@ -433,6 +786,52 @@ func (q *Queues) renderMessage(msg string) string {
 	return header + "\n" + box
 }
 
+// saveEditedJobCmd replaces the queued job with the edited payload.
+func (q *Queues) saveEditedJobCmd(entry *sidekiq.PositionedEntry, msg jobEditedMsg) tea.Cmd {
+	return ClientCmd(q.client, func() tea.Msg {
+		if msg.err != nil {
+			return jobSavedMsg{action: msg.action, original: msg.original, err: msg.err}
+		}
+		ctx := context.Background()
+		queue := q.client.NewQueue(entry.Queue())
+		if err := queue.ReplaceJob(ctx, entry.JobRecord, msg.value); err != nil {
+			return jobSavedMsg{action: msg.action, original: msg.original, err: err}
+		}
+		return jobSavedMsg{action: msg.action, original: msg.original, job: sidekiq.NewJobRecord(msg.value, entry.Queue())}
+	})
+}
+
+// handleJobSaved updates the edited job in the list, and in the job detail
+// while it still shows the job.
+func (q *Queues) handleJobSaved(msg jobSavedMsg) {
+	shown := q.detailEntry != nil && isEditedJob(q.detailEntry.JobRecord, msg.original)
+	if msg.err != nil {
+		if shown {
+			q.jobDetail.SetStatus(editStatus(msg.action, msg.err))
+		}
+		return
+	}
+	var updated *sidekiq.PositionedEntry
+	if shown {
+		updated = &sidekiq.PositionedEntry{JobRecord: msg.job, Position: q.detailEntry.Position}
+	}
+	for i, job := range q.jobs {
+		switch {
+		case shown && job == q.detailEntry:
+			q.jobs[i] = updated
+		case isEditedJob(job.JobRecord, msg.original):
+			q.jobs[i] = &sidekiq.PositionedEntry{JobRecord: msg.job, Position: job.Position}
+		}
+	}
+	q.updateTableRows()
+	if !shown {
+		return
+	}
+	q.detailEntry = updated
+	q.jobDetail.SetJob(updated.JobRecord)
+	q.jobDetail.SetStatus(editStatus(msg.action, nil))
+}
+
 // renderJobDetail renders the job detail view.
 func (q *Queues) renderJobDetail() string {
 	// Resize to account for missing queue list header area
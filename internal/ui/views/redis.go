@@ -0,0 +1,788 @@
+package views
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"charm.land/bubbles/v2/key"
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+	"github.com/kpumuk/lazykiq/internal/sidekiq"
+	"github.com/kpumuk/lazykiq/internal/ui/components/frame"
+	"github.com/kpumuk/lazykiq/internal/ui/components/messagebox"
+	"github.com/kpumuk/lazykiq/internal/ui/components/table"
+	"github.com/kpumuk/lazykiq/internal/ui/format"
+)
+
+// Redis view panes, switched with tab.
+const (
+	redisPaneHealth = iota
+	redisPaneSlowlog
+	redisPaneMemory
+	redisPaneCount
+)
+
+const (
+	// fragmentationWarning is the mem_fragmentation_ratio above which Redis
+	// wastes a noticeable share of its memory.
+	fragmentationWarning = 1.5
+	// slowlogCount is the number of SLOWLOG entries fetched, matching the
+	// default slowlog-max-len.
+	slowlogCount = 128
+	// latencyEventLines is the most latency events listed above the slowlog.
+	latencyEventLines = 5
+	// largestJobsHeight is the height of the largest jobs box below the
+	// memory table: a header, ten jobs, and the frame borders.
+	largestJobsHeight = 13
+)
+
+// redisHealthMsg carries Redis health from the fetch command to the Redis view.
+type redisHealthMsg struct {
+	health sidekiq.RedisHealth
+}
+
+// redisSlowlogMsg carries SLOWLOG and LATENCY LATEST results. Either command
+// may be disabled on managed Redis, so their errors are kept separately.
+type redisSlowlogMsg struct {
+	entries    []sidekiq.SlowlogEntry
+	events     []sidekiq.LatencyEvent
+	entriesErr error
+	eventsErr  error
+}
+
+// redisMemoryMsg carries the memory analysis. MEMORY USAGE may be disabled
+// on managed Redis, so the error is shown in the pane.
+type redisMemoryMsg struct {
+	report sidekiq.MemoryReport
+	err    error
+}
+
+var slowlogColumns = []table.Column{
+	{Title: "ID", Width: 6},
+	{Title: "Time", Width: 8},
+	{Title: "Duration", Width: 9},
+	{Title: "Client", Width: 21},
+	{Title: "Command", Width: 40},
+}
+
+var memoryColumns = []table.Column{
+	{Title: "Key", Width: 30},
+	{Title: "Keys", Width: 8},
+	{Title: "Size", Width: 10},
+	{Title: "Share", Width: 6},
+}
+
+// redisRow is a labeled value in a Redis panel section.
+type redisRow struct {
+	label string
+	value string
+	warn  bool
+}
+
+// redisSection groups rows under a title.
+type redisSection struct {
+	title string
+	rows  []redisRow
+}
+
+// Redis shows Redis server health and slow commands.
+type Redis struct {
+	client *sidekiq.Client
+	width  int
+	height int
+	styles Styles
+	pane   int
+
+	health sidekiq.RedisHealth
+	ready  bool
+
+	slowlog      redisSlowlogMsg
+	slowlogReady bool
+	slowTable    table.Model
+
+	memory        redisMemoryMsg
+	memoryReady   bool
+	memoryLoading bool
+	memoryTable   table.Model
+
+	keys RedisKeyMap
+}
+
+// NewRedis creates a new Redis view.
+func NewRedis(client *sidekiq.Client) *Redis {
+	return &Redis{
+		client: client,
+		keys:   DefaultRedisKeyMap(),
+		slowTable: table.New(
+			table.WithColumns(slowlogColumns),
+			table.WithEmptyMessage("No slow commands"),
+		),
+		memoryTable: table.New(
+			table.WithColumns(memoryColumns),
+			table.WithEmptyMessage("No Sidekiq keys"),
+		),
+	}
+}
+
+// fetchHealthCmd fetches Redis INFO.
+func (r *Redis) fetchHealthCmd() tea.Cmd {
+	return ClientCmd(r.client, func() tea.Msg {
+		health, err := r.client.GetRedisHealth(context.Background())
+		if err != nil {
+			return ConnectionErrorMsg{Err: err}
+		}
+		return redisHealthMsg{health: health}
+	})
+}
+
+// fetchSlowlogCmd fetches SLOWLOG entries and latency events.
+func (r *Redis) fetchSlowlogCmd() tea.Cmd {
+	return ClientCmd(r.client, func() tea.Msg {
+		ctx := context.Background()
+		var msg redisSlowlogMsg
+		msg.entries, msg.entriesErr = r.client.GetSlowlog(ctx, slowlogCount)
+		msg.events, msg.eventsErr = r.client.GetLatencyLatest(ctx)
+		return msg
+	})
+}
+
+// analyzeMemoryCmd measures memory used by Sidekiq keys.
+func (r *Redis) analyzeMemoryCmd() tea.Cmd {
+	r.memoryLoading = true
+	return ClientCmd(r.client, func() tea.Msg {
+		report, err := r.client.AnalyzeMemory(context.Background())
+		return redisMemoryMsg{report: report, err: err}
+	})
+}
+
+// fetchCmd fetches data for the active pane. The memory analysis touches
+// every Sidekiq key, so it only runs once and when asked to rescan.
+func (r *Redis) fetchCmd() tea.Cmd {
+	switch r.pane {
+	case redisPaneSlowlog:
+		return r.fetchSlowlogCmd()
+	case redisPaneMemory:
+		if r.memoryReady || r.memoryLoading {
+			return nil
+		}
+		return r.analyzeMemoryCmd()
+	default:
+		return r.fetchHealthCmd()
+	}
+}
+
+// activeTable returns the table of the active pane, or nil.
+func (r *Redis) activeTable() *table.Model {
+	switch r.pane {
+	case redisPaneSlowlog:
+		return &r.slowTable
+	case redisPaneMemory:
+		return &r.memoryTable
+	default:
+		return nil
+	}
+}
+
+// Init implements View.
+func (r *Redis) Init() tea.Cmd {
+	return r.fetchCmd()
+}
+
+// Update implements View.
+func (r *Redis) Update(msg tea.Msg) (View, tea.Cmd) {
+	switch msg := msg.(type) {
+	case redisHealthMsg:
+		r.health = msg.health
+		r.ready = true
+		return r, nil
+
+	case redisSlowlogMsg:
+		r.slowlog = msg
+		r.slowlogReady = true
+		r.updateSlowlogTable()
+		return r, nil
+
+	case redisMemoryMsg:
+		r.memory = msg
+		r.memoryReady = true
+		r.memoryLoading = false
+		r.updateMemoryTable()
+		return r, nil
+
+	case RefreshMsg:
+		return r, r.fetchCmd()
+
+	case tea.MouseWheelMsg:
+		if t := r.activeTable(); t != nil {
+			*t, _ = t.Update(msg)
+		}
+		return r, nil
+
+	case tea.MouseClickMsg:
+		if msg.Button != tea.MouseLeft {
+			return r, nil
+		}
+		switch r.pane {
+		case redisPaneSlowlog:
+			r.slowTable.ClickRow(msg.Y - r.latencyHeight() - frameBorderHeight)
+		case redisPaneMemory:
+			r.memoryTable.ClickRow(msg.Y - frameBorderHeight)
+		}
+		return r, nil
+
+	case tea.KeyMsg:
+		switch {
+		case key.Matches(msg, r.keys.NextPane):
+			r.pane = (r.pane + 1) % redisPaneCount
+			return r, r.fetchCmd()
+		case key.Matches(msg, r.keys.PrevPane):
+			r.pane = (r.pane + redisPaneCount - 1) % redisPaneCount
+			return r, r.fetchCmd()
+		case key.Matches(msg, r.keys.Rescan):
+			if r.pane == redisPaneMemory && !r.memoryLoading {
+				return r, r.analyzeMemoryCmd()
+			}
+		}
+		if t := r.activeTable(); t != nil {
+			*t, _ = t.Update(msg)
+		}
+		return r, nil
+	}
+
+	return r, nil
+}
+
+// View implements View.
+func (r *Redis) View() string {
+	switch r.pane {
+	case redisPaneSlowlog:
+		return r.renderSlowlog()
+	case redisPaneMemory:
+		return r.renderMemory()
+	default:
+		return r.renderHealth()
+	}
+}
+
+// renderHealth renders the health panel.
+func (r *Redis) renderHealth() string {
+	if !r.ready {
+		return messagebox.Render(messagebox.Styles{
+			Title:  r.styles.Title,
+			Muted:  r.styles.Muted,
+			Border: r.styles.FocusBorder,
+		}, "Health", "Loading...", r.width, r.height)
+	}
+
+	contentWidth := max(r.width-4, 1)
+	var lines []string
+	for _, warning := range r.warnings() {
+		lines = append(lines, r.styles.ChartFailure.Bold(true).Width(contentWidth).Render("⚠ "+warning))
+	}
+	if len(lines) > 0 {
+		lines = append(lines, "")
+	}
+	lines = append(lines, r.renderSections(r.sections(), contentWidth))
+
+	meta := r.styles.MetricLabel.Render("version: ") + r.styles.MetricValue.Render(orFallback(r.health.Version, "n/a"))
+	return frame.New(
+		frame.WithStyles(frame.Styles{
+			Focused: frame.StyleState{
+				Title:  r.styles.Title,
+				Border: r.styles.FocusBorder,
+			},
+			Blurred: frame.StyleState{
+				Title:  r.styles.Title,
+				Border: r.styles.BorderStyle,
+			},
+		}),
+		frame.WithTitle("Health"),
+		frame.WithTitlePadding(0),
+		frame.WithMeta(meta),
+		frame.WithContent(strings.Join(lines, "\n")),
+		frame.WithPadding(1),
+		frame.WithSize(r.width, r.height),
+		frame.WithFocused(true),
+	).View()
+}
+
+// Name implements View.
+func (r *Redis) Name() string {
+	return "Redis"
+}
+
+// ShortHelp implements View.
+func (r *Redis) ShortHelp() []key.Binding {
+	return nil
+}
+
+// FullHelp implements View.
+func (r *Redis) FullHelp() []HelpSection {
+	sections := []HelpSection{
+		{
+			Title: "Redis",
+			Bindings: []key.Binding{
+				r.keys.NextPane,
+				r.keys.PrevPane,
+			},
+		},
+	}
+	if r.pane == redisPaneMemory {
+		sections[0].Bindings = append(sections[0].Bindings, r.keys.Rescan)
+	}
+	if t := r.activeTable(); t != nil {
+		sections = append(sections, tableHelp(*t))
+	}
+	return sections
+}
+
+// SetSize implements View.
+func (r *Redis) SetSize(width, height int) View {
+	r.width = width
+	r.height = height
+	r.updateTableSize()
+	return r
+}
+
+// SetKeyMaps implements KeyMapConfigurable.
+func (r *Redis) SetKeyMaps(keys KeyMaps) {
+	r.keys = keys.Redis
+	r.slowTable.KeyMap = keys.Table
+	r.memoryTable.KeyMap = keys.Table
+}
+
+// SetStyles implements View.
+func (r *Redis) SetStyles(styles Styles) View {
+	r.styles = styles
+	tableStyles := table.Styles{
+		Text:      styles.Text,
+		Muted:     styles.Muted,
+		Header:    styles.TableHeader,
+		Selected:  styles.TableSelected,
+		Separator: styles.TableSeparator,
+		Highlight: styles.NavKey,
+	}
+	r.slowTable.SetStyles(tableStyles)
+	r.memoryTable.SetStyles(tableStyles)
+	return r
+}
+
+// updateSlowlogTable fills the slowlog table, highlighting commands that
+// touch Sidekiq keys.
+func (r *Redis) updateSlowlogTable() {
+	entries := r.slowlog.entries
+	rows := make([]table.Row, len(entries))
+	highlighted := make([]bool, len(entries))
+	for i, entry := range entries {
+		rows[i] = table.Row{
+			strconv.FormatInt(entry.ID, 10),
+			entry.Time.Format("15:04:05"),
+			formatRedisDuration(entry.Duration),
+			entry.Client(),
+			entry.Command(),
+		}
+		highlighted[i] = entry.TouchesSidekiq()
+	}
+	emptyMessage := "No slow commands"
+	if r.slowlog.entriesErr != nil {
+		emptyMessage = "SLOWLOG unavailable: " + r.slowlog.entriesErr.Error()
+	}
+	r.slowTable.SetEmptyMessage(emptyMessage)
+	r.slowTable.SetRows(rows)
+	r.slowTable.SetHighlighted(highlighted)
+	r.updateTableSize()
+}
+
+func (r *Redis) updateTableSize() {
+	// Latency lines, then the table frame borders and padding
+	r.slowTable.SetSize(max(r.width-4, 1), max(r.height-r.latencyHeight()-2, 3))
+	// The largest jobs box sits below the memory table
+	r.memoryTable.SetSize(max(r.width-4, 1), max(r.height-largestJobsHeight-2, 3))
+}
+
+// latencyHeight returns the height of the latency event list above the
+// slowlog table.
+func (r *Redis) latencyHeight() int {
+	return len(r.latencyLines())
+}
+
+// latencyLines lists the latest latency spikes per event.
+func (r *Redis) latencyLines() []string {
+	if !r.slowlogReady {
+		return nil
+	}
+	if r.slowlog.eventsErr != nil {
+		return []string{r.styles.Muted.Render("LATENCY LATEST unavailable: " + r.slowlog.eventsErr.Error())}
+	}
+	if len(r.slowlog.events) == 0 {
+		return []string{r.styles.Muted.Render("No latency events (set latency-monitor-threshold to record them)")}
+	}
+	events := r.slowlog.events
+	if len(events) > latencyEventLines {
+		events = events[:latencyEventLines]
+	}
+	lines := make([]string, len(events))
+	for i, event := range events {
+		lines[i] = r.styles.MetricLabel.Render(event.Event+": ") +
+			r.styles.MetricValue.Render(formatRedisDuration(event.Latest)) +
+			r.styles.Muted.Render(fmt.Sprintf(" (max %s) %s ago", formatRedisDuration(event.Max), format.Duration(int64(time.Since(event.Time).Seconds()))))
+	}
+	return lines
+}
+
+// renderSlowlog renders latency events above the slowlog table.
+func (r *Redis) renderSlowlog() string {
+	if !r.slowlogReady {
+		return messagebox.Render(messagebox.Styles{
+			Title:  r.styles.Title,
+			Muted:  r.styles.Muted,
+			Border: r.styles.FocusBorder,
+		}, "Slowlog", "Loading...", r.width, r.height)
+	}
+
+	var header string
+	if lines := r.latencyLines(); len(lines) > 0 {
+		header = lipgloss.NewStyle().MaxWidth(r.width).Render(r.styles.BoxPadding.Render(strings.Join(lines, "\n")))
+	}
+
+	sidekiqCount := 0
+	for _, entry := range r.slowlog.entries {
+		if entry.TouchesSidekiq() {
+			sidekiqCount++
+		}
+	}
+	meta := r.styles.MetricLabel.Render("entries: ") + r.styles.MetricValue.Render(strconv.Itoa(len(r.slowlog.entries))) +
+		r.styles.Muted.Render(" • ") +
+		r.styles.MetricLabel.Render("sidekiq: ") + r.styles.NavKey.Render(strconv.Itoa(sidekiqCount))
+
+	box := frame.New(
+		frame.WithStyles(frame.Styles{
+			Focused: frame.StyleState{
+				Title:  r.styles.Title,
+				Border: r.styles.FocusBorder,
+			},
+			Blurred: frame.StyleState{
+				Title:  r.styles.Title,
+				Border: r.styles.BorderStyle,
+			},
+		}),
+		frame.WithTitle("Slowlog"),
+		frame.WithTitlePadding(0),
+		frame.WithMeta(meta),
+		frame.WithContent(r.slowTable.View()),
+		frame.WithPadding(1),
+		frame.WithSize(r.width, r.height-r.latencyHeight()),
+		frame.WithMinHeight(5),
+		frame.WithFocused(true),
+	).View()
+
+	if header == "" {
+		return box
+	}
+	return lipgloss.JoinVertical(lipgloss.Left, header, box)
+}
+
+// updateMemoryTable fills the memory table with the size of each group of
+// Sidekiq keys and its share of Redis memory.
+func (r *Redis) updateMemoryTable() {
+	groups := r.memory.report.Groups
+	rows := make([]table.Row, len(groups))
+	for i, group := range groups {
+		share := "n/a"
+		if used := r.memory.report.UsedMemory; used > 0 {
+			share = fmt.Sprintf("%.1f%%", float64(group.Bytes)/float64(used)*100)
+		}
+		rows[i] = table.Row{
+			group.Name,
+			format.Number(int64(group.Keys)),
+			format.Bytes(group.Bytes),
+			share,
+		}
+	}
+	emptyMessage := "No Sidekiq keys"
+	if r.memory.err != nil {
+		emptyMessage = "MEMORY USAGE unavailable: " + r.memory.err.Error()
+	}
+	r.memoryTable.SetEmptyMessage(emptyMessage)
+	r.memoryTable.SetRows(rows)
+}
+
+// renderMemory renders memory used per group of Sidekiq keys above the
+// largest sampled job payloads.
+func (r *Redis) renderMemory() string {
+	if !r.memoryReady {
+		return messagebox.Render(messagebox.Styles{
+			Title:  r.styles.Title,
+			Muted:  r.styles.Muted,
+			Border: r.styles.FocusBorder,
+		}, "Memory", "Analyzing...", r.width, r.height)
+	}
+
+	report := r.memory.report
+	meta := r.styles.MetricLabel.Render("sidekiq: ") + r.styles.MetricValue.Render(format.Bytes(report.TotalBytes()))
+	if report.UsedMemory > 0 {
+		meta += r.styles.Muted.Render(" of ") + r.styles.MetricValue.Render(format.Bytes(report.UsedMemory))
+	}
+	if r.memoryLoading {
+		meta += r.styles.Muted.Render(" • rescanning...")
+	}
+
+	frameStyles := frame.Styles{
+		Focused: frame.StyleState{
+			Title:  r.styles.Title,
+			Border: r.styles.FocusBorder,
+		},
+		Blurred: frame.StyleState{
+			Title:  r.styles.Title,
+			Border: r.styles.BorderStyle,
+		},
+	}
+	keysBox := frame.New(
+		frame.WithStyles(frameStyles),
+		frame.WithTitle("Memory"),
+		frame.WithTitlePadding(0),
+		frame.WithMeta(meta),
+		frame.WithContent(r.memoryTable.View()),
+		frame.WithPadding(1),
+		frame.WithSize(r.width, r.height-largestJobsHeight),
+		frame.WithMinHeight(5),
+		frame.WithFocused(true),
+	).View()
+
+	jobsMeta := r.styles.MetricLabel.Render("sampled: ") + r.styles.MetricValue.Render(format.Number(int64(report.SampledJobs)))
+	jobsBox := frame.New(
+		frame.WithStyles(frameStyles),
+		frame.WithTitle("Largest jobs"),
+		frame.WithTitlePadding(0),
+		frame.WithMeta(jobsMeta),
+		frame.WithContent(r.largestJobsContent(max(r.width-4, 1))),
+		frame.WithPadding(1),
+		frame.WithSize(r.width, largestJobsHeight),
+		frame.WithFocused(false),
+	).View()
+
+	return lipgloss.JoinVertical(lipgloss.Left, keysBox, jobsBox)
+}
+
+// largestJobsContent lists the largest sampled payloads with their class
+// and where they are stored.
+func (r *Redis) largestJobsContent(width int) string {
+	jobs := r.memory.report.LargestJobs
+	if len(jobs) == 0 {
+		return r.styles.Muted.Render("No jobs sampled")
+	}
+
+	sizes := make([]string, len(jobs))
+	sizeWidth, classWidth, sourceWidth := len("Size"), len("Class"), len("Source")
+	for i, job := range jobs {
+		sizes[i] = format.Bytes(int64(job.Bytes))
+		sizeWidth = max(sizeWidth, lipgloss.Width(sizes[i]))
+		classWidth = max(classWidth, lipgloss.Width(job.Class))
+		sourceWidth = max(sourceWidth, lipgloss.Width(job.Source))
+	}
+	row := func(size, class, source, jid string) string {
+		return fmt.Sprintf("%*s  %-*s  %-*s  %s", sizeWidth, size, classWidth, class, sourceWidth, source, jid)
+	}
+
+	lines := []string{r.styles.TableHeader.Render(row("Size", "Class", "Source", "JID"))}
+	for i, job := range jobs {
+		lines = append(lines, r.styles.Text.Render(row(sizes[i], job.Class, job.Source, job.JID)))
+	}
+	return lipgloss.NewStyle().MaxWidth(width).Render(strings.Join(lines, "\n"))
+}
+
+// formatRedisDuration formats command durations, which are usually well
+// below a second.
+func formatRedisDuration(d time.Duration) string {
+	switch {
+	case d >= time.Second:
+		return fmt.Sprintf("%.2fs", d.Seconds())
+	case d >= time.Millisecond:
+		return fmt.Sprintf("%.1fms", float64(d)/float64(time.Millisecond))
+	default:
+		return fmt.Sprintf("%dµs", d.Microseconds())
+	}
+}
+
+// warnings lists health problems worth calling out above the panel.
+func (r *Redis) warnings() []string {
+	h := r.health
+	var warnings []string
+	if !h.EvictionSafe() {
+		warnings = append(warnings, fmt.Sprintf(
+			"maxmemory-policy is %s: Redis may evict Sidekiq jobs under memory pressure. Sidekiq requires %s.",
+			h.MaxMemoryPolicy, sidekiq.RecommendedEvictionPolicy))
+	}
+	if h.FragmentationRatio > fragmentationWarning {
+		warnings = append(warnings, fmt.Sprintf("Memory fragmentation ratio is %.2f.", h.FragmentationRatio))
+	}
+	if h.RDBLastBgsaveStatus != "" && h.RDBLastBgsaveStatus != "ok" {
+		warnings = append(warnings, "Last RDB save failed.")
+	}
+	if h.AOFEnabled && h.AOFLastWriteStatus != "" && h.AOFLastWriteStatus != "ok" {
+		warnings = append(warnings, "Last AOF write failed.")
+	}
+	if h.MasterLinkStatus != "" && h.MasterLinkStatus != "up" {
+		warnings = append(warnings, "Replication link to the master is "+h.MasterLinkStatus+".")
+	}
+	return warnings
+}
+
+// sections builds the panel contents from the latest health data.
+func (r *Redis) sections() []redisSection {
+	h := r.health
+
+	maxMemory := "unlimited"
+	if h.MaxMemory > 0 {
+		maxMemory = orFallback(h.MaxMemoryHuman, format.Number(h.MaxMemory))
+	}
+	hitRatio := "n/a"
+	if ratio, ok := h.HitRatio(); ok {
+		hitRatio = fmt.Sprintf("%.1f%%", ratio*100)
+	}
+
+	sections := []redisSection{
+		{
+			title: "Server",
+			rows: []redisRow{
+				{label: "Version", value: orFallback(h.Version, "n/a")},
+				{label: "Mode", value: orFallback(h.Mode, "n/a")},
+				{label: "Uptime", value: format.Duration(h.UptimeSeconds)},
+			},
+		},
+		{
+			title: "Memory",
+			rows: []redisRow{
+				{label: "Used", value: orFallback(h.UsedMemoryHuman, "n/a")},
+				{label: "RSS", value: orFallback(h.UsedMemoryRSSHuman, "n/a")},
+				{label: "Peak", value: orFallback(h.UsedMemoryPeakHuman, "n/a")},
+				{label: "Fragmentation", value: fmt.Sprintf("%.2f", h.FragmentationRatio), warn: h.FragmentationRatio > fragmentationWarning},
+				{label: "Maxmemory", value: maxMemory},
+				{label: "Eviction policy", value: orFallback(h.MaxMemoryPolicy, "n/a"), warn: !h.EvictionSafe()},
+			},
+		},
+		{
+			title: "Activity",
+			rows: []redisRow{
+				{label: "Clients", value: format.Number(h.ConnectedClients)},
+				{label: "Blocked clients", value: format.Number(h.BlockedClients)},
+				{label: "Ops/sec", value: format.Number(h.OpsPerSec)},
+				{label: "Hit ratio", value: hitRatio},
+				{label: "Evicted keys", value: format.Number(h.EvictedKeys), warn: h.EvictedKeys > 0},
+				{label: "Expired keys", value: format.Number(h.ExpiredKeys)},
+			},
+		},
+		{
+			title: "Persistence",
+			rows: []redisRow{
+				{label: "Last RDB save", value: r.lastSave()},
+				{label: "RDB status", value: r.rdbStatus(), warn: h.RDBLastBgsaveStatus != "" && h.RDBLastBgsaveStatus != "ok"},
+				{label: "Unsaved changes", value: format.Number(h.RDBChangesSinceSave)},
+				{label: "AOF", value: r.aofStatus(), warn: h.AOFEnabled && h.AOFLastWriteStatus != "" && h.AOFLastWriteStatus != "ok"},
+			},
+		},
+		{
+			title: "Replication",
+			rows:  r.replicationRows(),
+		},
+	}
+
+	keyspace := redisSection{title: "Keyspace"}
+	for _, db := range h.Keyspace {
+		keyspace.rows = append(keyspace.rows, redisRow{
+			label: db.DB,
+			value: fmt.Sprintf("%s keys, %s expiring", format.Number(db.Keys), format.Number(db.Expires)),
+		})
+	}
+	if len(keyspace.rows) == 0 {
+		keyspace.rows = []redisRow{{label: "Keys", value: "0"}}
+	}
+	return append(sections, keyspace)
+}
+
+func (r *Redis) lastSave() string {
+	if r.health.RDBLastSaveTime <= 0 {
+		return "never"
+	}
+	return format.Duration(time.Now().Unix()-r.health.RDBLastSaveTime) + " ago"
+}
+
+func (r *Redis) rdbStatus() string {
+	status := orFallback(r.health.RDBLastBgsaveStatus, "n/a")
+	if r.health.RDBBgsaveInProgress {
+		status += " (saving)"
+	}
+	return status
+}
+
+func (r *Redis) aofStatus() string {
+	if !r.health.AOFEnabled {
+		return "disabled"
+	}
+	status := "enabled, " + orFallback(r.health.AOFLastWriteStatus, "n/a")
+	if r.health.AOFRewriteInProgress {
+		status += " (rewriting)"
+	}
+	return status
+}
+
+func (r *Redis) replicationRows() []redisRow {
+	h := r.health
+	rows := []redisRow{{label: "Role", value: orFallback(h.Role, "n/a")}}
+	if h.MasterHost != "" {
+		rows = append(rows,
+			redisRow{label: "Master", value: h.MasterHost + ":" + h.MasterPort},
+			redisRow{label: "Link", value: orFallback(h.MasterLinkStatus, "n/a"), warn: h.MasterLinkStatus != "" && h.MasterLinkStatus != "up"},
+		)
+	}
+	return append(rows, redisRow{label: "Replicas", value: format.Number(h.ConnectedReplicas)})
+}
+
+// renderSections lays sections out in as many columns as fit the width.
+func (r *Redis) renderSections(sections []redisSection, width int) string {
+	const gap = 4
+
+	blocks := make([]string, len(sections))
+	blockWidth := 0
+	for i, section := range sections {
+		blocks[i] = r.renderSection(section)
+		blockWidth = max(blockWidth, lipgloss.Width(blocks[i]))
+	}
+
+	columns := max((width+gap)/(blockWidth+gap), 1)
+	cell := lipgloss.NewStyle().Width(blockWidth + gap)
+	var gridRows []string
+	for start := 0; start < len(blocks); start += columns {
+		end := min(start+columns, len(blocks))
+		cells := make([]string, 0, end-start)
+		for _, block := range blocks[start:end] {
+			cells = append(cells, cell.Render(block))
+		}
+		if len(gridRows) > 0 {
+			gridRows = append(gridRows, "")
+		}
+		gridRows = append(gridRows, lipgloss.JoinHorizontal(lipgloss.Top, cells...))
+	}
+	return lipgloss.NewStyle().MaxWidth(width).Render(strings.Join(gridRows, "\n"))
+}
+
+func (r *Redis) renderSection(section redisSection) string {
+	labelWidth := 0
+	for _, row := range section.rows {
+		labelWidth = max(labelWidth, lipgloss.Width(row.label))
+	}
+
+	lines := []string{r.styles.Title.Render(section.title)}
+	for _, row := range section.rows {
+		value := r.styles.MetricValue.Render(row.value)
+		if row.warn {
+			value = r.styles.ChartFailure.Bold(true).Render(row.value)
+		}
+		padding := strings.Repeat(" ", labelWidth-lipgloss.Width(row.label))
+		lines = append(lines, r.styles.MetricLabel.Render(row.label)+padding+"  "+value)
+	}
+	return strings.Join(lines, "\n")
+}
@@ -7,6 +7,7 @@ import (
 
 	"charm.land/bubbles/v2/key"
 	tea "charm.land/bubbletea/v2"
+	"github.com/kpumuk/lazykiq/internal/config"
 	"github.com/kpumuk/lazykiq/internal/sidekiq"
 	"github.com/kpumuk/lazykiq/internal/ui/components/filterinput"
 	"github.com/kpumuk/lazykiq/internal/ui/components/frame"
@@ -29,8 +30,6 @@ const retriesPageSize = 25
 // Retries shows failed jobs pending retry.
 type Retries struct {
 	client      *sidekiq.Client
-	width       int
-	height      int
 	styles      Styles
 	jobs        []*sidekiq.SortedEntry
 	table       table.Model
@@ -39,30 +38,36 @@ type Retries struct {
 	totalPages  int
 	totalSize   int64
 	filter      filterinput.Model
-
-	// Job detail state
-	showDetail bool
-	jobDetail  jobdetail.Model
+	columns     columnSet[*sidekiq.SortedEntry]
+	keys        JobListKeyMap
+	pending     pendingJob
+	splitList
 }
 
 // NewRetries creates a new Retries view.
 func NewRetries(client *sidekiq.Client) *Retries {
-	return &Retries{
+	columns := newColumnSet(retryJobColumns, sortedEntryRecord, retryDefaultColumns)
+	r := &Retries{
 		client:      client,
 		currentPage: 1,
 		totalPages:  1,
 		filter:      filterinput.New(),
+		columns:     columns,
+		keys:        DefaultJobListKeyMap(),
 		table: table.New(
-			table.WithColumns(retryJobColumns),
+			table.WithColumns(columns.tableColumns()),
 			table.WithEmptyMessage("No retries"),
 		),
-		jobDetail: jobdetail.New(),
 	}
+	r.splitList = newSplitList(&r.table, &r.filter, &r.jobs, jobdetail.New(
+		jobdetail.WithActions(jobdetail.ActionEdit, jobdetail.ActionEditEnqueue),
+	))
+	return r
 }
 
 // fetchDataCmd fetches retry jobs data from Redis.
 func (r *Retries) fetchDataCmd() tea.Cmd {
-	return func() tea.Msg {
+	return ClientCmd(r.client, func() tea.Msg {
 		ctx := context.Background()
 
 		if r.filter.Query() != "" {
@@ -105,7 +110,7 @@ func (r *Retries) fetchDataCmd() tea.Cmd {
 			totalPages:  totalPages,
 			totalSize:   totalSize,
 		}
-	}
+	})
 }
 
 // Init implements View.
@@ -118,19 +123,58 @@ func (r *Retries) Init() tea.Cmd {
 
 // Update implements View.
 func (r *Retries) Update(msg tea.Msg) (View, tea.Cmd) {
+	if msg, ok := msg.(ShowJobMsg); ok {
+		r.showDetail = false
+		r.pending.request(msg.JID, &r.filter, &r.table)
+		r.currentPage = 1
+		return r, r.fetchDataCmd()
+	}
+
+	// In the split layout, mouse events over the detail pane focus it
+	if cmd, ok := r.updateSplitMouse(msg); ok {
+		return r, cmd
+	}
+
+	// Relative times are computed when building rows. They refresh even
+	// while the job is focused, as the list stays visible in the split layout.
+	if _, ok := msg.(RenderTickMsg); ok {
+		r.updateTableRows()
+		return r, nil
+	}
+
+	// Edits finish after the detail may have been closed
+	switch msg := msg.(type) {
+	case jobEditedMsg:
+		if r.detailEntry == nil || r.detailEntry.JobRecord != msg.original {
+			return r, nil
+		}
+		return r, saveSortedEntryCmd(r.client, sidekiq.RetrySet, r.detailEntry, msg)
+	case jobSavedMsg:
+		if r.handleJobSaved(msg) {
+			return r, r.fetchDataCmd()
+		}
+		r.updateTableRows()
+		return r, nil
+	}
+
 	// If showing detail, delegate to detail component
 	if r.showDetail {
 		switch msg := msg.(type) {
 		case tea.KeyMsg:
-			if msg.String() == "esc" {
+			if key.Matches(msg, r.jobDetail.KeyMap.Close) {
 				r.showDetail = false
 				return r, nil
 			}
+		case jobdetail.ActionMsg:
+			return r, editJobCmd(r.client, msg.Action, msg.Job)
 		}
-		r.jobDetail, _ = r.jobDetail.Update(msg)
-		return r, nil
+		var cmd tea.Cmd
+		r.jobDetail, cmd = r.jobDetail.Update(msg)
+		return r, cmd
 	}
 
+	defer r.syncDetail()
+
 	switch msg := msg.(type) {
 	case retriesDataMsg:
 		r.jobs = msg.jobs
@@ -139,6 +183,9 @@ func (r *Retries) Update(msg tea.Msg) (View, tea.Cmd) {
 		r.totalSize = msg.totalSize
 		r.ready = true
 		r.updateTableRows()
+		if entry := r.pending.take(r.jobs); entry != nil {
+			r.openDetail(entry)
+		}
 		return r, nil
 
 	case RefreshMsg:
@@ -152,16 +199,26 @@ func (r *Retries) Update(msg tea.Msg) (View, tea.Cmd) {
 		}
 		return r, nil
 
+	case tea.MouseWheelMsg:
+		r.table, _ = r.table.Update(msg)
+		return r, nil
+
+	case tea.MouseClickMsg:
+		if msg.Button == tea.MouseLeft {
+			r.table.ClickRow(msg.Y - frameBorderHeight - filterHeight)
+		}
+		return r, nil
+
 	case tea.KeyMsg:
 		wasFocused := r.filter.Focused()
 		var cmd tea.Cmd
 		r.filter, cmd = r.filter.Update(msg)
-		if wasFocused || msg.String() == "/" || msg.String() == "esc" || msg.String() == "ctrl+u" {
+		if wasFocused || key.Matches(msg, r.filter.KeyMap.Focus, r.filter.KeyMap.Clear) {
 			return r, cmd
 		}
 
-		switch msg.String() {
-		case "alt+left", "[":
+		switch {
+		case key.Matches(msg, r.keys.PrevPage):
 			if r.filter.Query() != "" {
 				return r, nil
 			}
@@ -170,7 +227,7 @@ func (r *Retries) Update(msg tea.Msg) (View, tea.Cmd) {
 				return r, r.fetchDataCmd()
 			}
 			return r, nil
-		case "alt+right", "]":
+		case key.Matches(msg, r.keys.NextPage):
 			if r.filter.Query() != "" {
 				return r, nil
 			}
@@ -179,12 +236,8 @@ func (r *Retries) Update(msg tea.Msg) (View, tea.Cmd) {
 				return r, r.fetchDataCmd()
 			}
 			return r, nil
-		case "enter":
-			// Show detail for selected job
-			if idx := r.table.Cursor(); idx >= 0 && idx < len(r.jobs) {
-				r.jobDetail.SetJob(r.jobs[idx].JobRecord)
-				r.showDetail = true
-			}
+		case key.Matches(msg, r.keys.Open):
+			r.openSelected()
 			return r, nil
 		}
 
@@ -198,10 +251,11 @@ func (r *Retries) Update(msg tea.Msg) (View, tea.Cmd) {
 
 // View implements View.
 func (r *Retries) View() string {
-	if r.showDetail {
-		return r.renderJobDetail()
-	}
+	return r.view(r.styles, r.renderList)
+}
 
+// renderList renders the job list, or a message while it is loading or empty.
+func (r *Retries) renderList() string {
 	if !r.ready {
 		return r.renderMessage("Loading...")
 	}
@@ -218,7 +272,7 @@ func (r *Retries) renderMessage(msg string) string {
 		Title:  r.styles.Title,
 		Muted:  r.styles.Muted,
 		Border: r.styles.FocusBorder,
-	}, "Retries", msg, r.width, r.height)
+	}, "Retries", msg, r.listWidth(), r.height)
 }
 
 // Name implements View.
@@ -231,13 +285,17 @@ func (r *Retries) ShortHelp() []key.Binding {
 	return nil
 }
 
+// FullHelp implements View.
+func (r *Retries) FullHelp() []HelpSection {
+	if r.showDetail {
+		return jobDetailHelp(r.jobDetail)
+	}
+	return jobListHelp(r.keys, "Retries", r.filter, r.table)
+}
+
 // SetSize implements View.
 func (r *Retries) SetSize(width, height int) View {
-	r.width = width
-	r.height = height
-	r.updateTableSize()
-	// Update job detail size (full size, component handles its own borders)
-	r.jobDetail.SetSize(width, height)
+	r.setSize(width, height)
 	return r
 }
 
@@ -246,6 +304,24 @@ func (r *Retries) FilterFocused() bool {
 	return r.filter.Focused()
 }
 
+// SetColumns implements ColumnConfigurable.
+func (r *Retries) SetColumns(columns []config.Column) error {
+	if err := r.columns.configure(columns); err != nil {
+		return err
+	}
+	r.table.SetColumns(r.columns.tableColumns())
+	r.updateTableRows()
+	return nil
+}
+
+// SetKeyMaps implements KeyMapConfigurable.
+func (r *Retries) SetKeyMaps(keys KeyMaps) {
+	r.keys = keys.Jobs
+	r.table.KeyMap = keys.Table
+	r.filter.KeyMap = keys.Filter
+	r.jobDetail.KeyMap = keys.JobDetail
+}
+
 // SetStyles implements View.
 func (r *Retries) SetStyles(styles Styles) View {
 	r.styles = styles
@@ -277,29 +353,21 @@ func (r *Retries) SetStyles(styles Styles) View {
 		PanelTitle:      styles.Title,
 		FocusBorder:     styles.FocusBorder,
 		Muted:           styles.Muted,
+		Selected:        styles.TableSelected,
 	})
 	return r
 }
 
-// Table columns for retry job list.
-var retryJobColumns = []table.Column{
-	{Title: "Next Retry", Width: 12},
-	{Title: "Retries", Width: 7},
-	{Title: "Queue", Width: 15},
-	{Title: "Job", Width: 30},
-	{Title: "Arguments", Width: 40},
-	{Title: "Error", Width: 60},
+// Table columns for retry job list, in addition to the shared job record columns.
+var retryJobColumns = []jobColumn[*sidekiq.SortedEntry]{
+	{name: "next_retry", title: "Next Retry", width: 12, value: func(job *sidekiq.SortedEntry) string {
+		// Format "next retry" as relative time (negative means in the past/due)
+		return format.Duration(time.Now().Unix() - job.At())
+	}},
 }
 
-// updateTableSize updates the table dimensions based on current view size.
-func (r *Retries) updateTableSize() {
-	// Calculate table height: total height - box borders
-	tableHeight := max(r.height-3, 3)
-	// Table width: view width - box borders - padding
-	tableWidth := r.width - 4
-	r.table.SetSize(tableWidth, tableHeight)
-	r.filter.SetWidth(tableWidth)
-}
+// retryDefaultColumns lists the columns shown unless overridden in the config file.
+var retryDefaultColumns = []string{"next_retry", "retry_count", "queue", "class", "args", "error"}
 
 // updateTableRows converts job data to table rows.
 func (r *Retries) updateTableRows() {
@@ -310,36 +378,11 @@ func (r *Retries) updateTableRows() {
 	}
 
 	rows := make([]table.Row, 0, len(r.jobs))
-	now := time.Now().Unix()
 	for _, job := range r.jobs {
-		// Format "next retry" as relative time (negative means in the past/due)
-		nextRetry := format.Duration(now - job.At())
-
-		// Format retry count
-		retryCount := fmt.Sprintf("%d", job.RetryCount())
-
-		// Format error
-		errorStr := ""
-		if job.HasError() {
-			errorStr = fmt.Sprintf("%s: %s", job.ErrorClass(), job.ErrorMessage())
-			// Truncate if too long
-			if len(errorStr) > 100 {
-				errorStr = errorStr[:97] + "..."
-			}
-		}
-
-		row := table.Row{
-			nextRetry,
-			retryCount,
-			job.Queue(),
-			job.DisplayClass(),
-			format.Args(job.DisplayArgs()),
-			errorStr,
-		}
-		rows = append(rows, row)
+		rows = append(rows, r.columns.row(job))
 	}
 	r.table.SetRows(rows)
-	r.updateTableSize()
+	r.resize()
 }
 
 // renderJobsBox renders the bordered box containing the jobs table.
@@ -369,14 +412,9 @@ func (r *Retries) renderJobsBox() string {
 		frame.WithMeta(meta),
 		frame.WithContent(content),
 		frame.WithPadding(1),
-		frame.WithSize(r.width, r.height),
+		frame.WithSize(r.listWidth(), r.height),
 		frame.WithMinHeight(5),
-		frame.WithFocused(true),
+		frame.WithFocused(!r.showDetail),
 	)
 	return box.View()
 }
-
-// renderJobDetail renders the job detail view.
-func (r *Retries) renderJobDetail() string {
-	return r.jobDetail.View()
-}
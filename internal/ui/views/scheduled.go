@@ -7,6 +7,7 @@ import (
 
 	"charm.land/bubbles/v2/key"
 	tea "charm.land/bubbletea/v2"
+	"github.com/kpumuk/lazykiq/internal/config"
 	"github.com/kpumuk/lazykiq/internal/sidekiq"
 	"github.com/kpumuk/lazykiq/internal/ui/components/filterinput"
 	"github.com/kpumuk/lazykiq/internal/ui/components/frame"
@@ -29,8 +30,6 @@ type scheduledDataMsg struct {
 // Scheduled shows jobs scheduled for future execution.
 type Scheduled struct {
 	client      *sidekiq.Client
-	width       int
-	height      int
 	styles      Styles
 	jobs        []*sidekiq.SortedEntry
 	table       table.Model
@@ -39,30 +38,36 @@ type Scheduled struct {
 	totalPages  int
 	totalSize   int64
 	filter      filterinput.Model
-
-	// Job detail state
-	showDetail bool
-	jobDetail  jobdetail.Model
+	columns     columnSet[*sidekiq.SortedEntry]
+	keys        JobListKeyMap
+	pending     pendingJob
+	splitList
 }
 
 // NewScheduled creates a new Scheduled view.
 func NewScheduled(client *sidekiq.Client) *Scheduled {
-	return &Scheduled{
+	columns := newColumnSet(scheduledJobColumns, sortedEntryRecord, scheduledDefaultColumns)
+	s := &Scheduled{
 		client:      client,
 		currentPage: 1,
 		totalPages:  1,
 		filter:      filterinput.New(),
+		columns:     columns,
+		keys:        DefaultJobListKeyMap(),
 		table: table.New(
-			table.WithColumns(scheduledJobColumns),
+			table.WithColumns(columns.tableColumns()),
 			table.WithEmptyMessage("No scheduled jobs"),
 		),
-		jobDetail: jobdetail.New(),
 	}
+	s.splitList = newSplitList(&s.table, &s.filter, &s.jobs, jobdetail.New(
+		jobdetail.WithActions(jobdetail.ActionEdit, jobdetail.ActionEditEnqueue),
+	))
+	return s
 }
 
 // fetchDataCmd fetches scheduled jobs data from Redis.
 func (s *Scheduled) fetchDataCmd() tea.Cmd {
-	return func() tea.Msg {
+	return ClientCmd(s.client, func() tea.Msg {
 		ctx := context.Background()
 
 		if s.filter.Query() != "" {
@@ -105,7 +110,7 @@ func (s *Scheduled) fetchDataCmd() tea.Cmd {
 			totalPages:  totalPages,
 			totalSize:   totalSize,
 		}
-	}
+	})
 }
 
 // Init implements View.
@@ -118,19 +123,58 @@ func (s *Scheduled) Init() tea.Cmd {
 
 // Update implements View.
 func (s *Scheduled) Update(msg tea.Msg) (View, tea.Cmd) {
+	if msg, ok := msg.(ShowJobMsg); ok {
+		s.showDetail = false
+		s.pending.request(msg.JID, &s.filter, &s.table)
+		s.currentPage = 1
+		return s, s.fetchDataCmd()
+	}
+
+	// In the split layout, mouse events over the detail pane focus it
+	if cmd, ok := s.updateSplitMouse(msg); ok {
+		return s, cmd
+	}
+
+	// Relative times are computed when building rows. They refresh even
+	// while the job is focused, as the list stays visible in the split layout.
+	if _, ok := msg.(RenderTickMsg); ok {
+		s.updateTableRows()
+		return s, nil
+	}
+
+	// Edits finish after the detail may have been closed
+	switch msg := msg.(type) {
+	case jobEditedMsg:
+		if s.detailEntry == nil || s.detailEntry.JobRecord != msg.original {
+			return s, nil
+		}
+		return s, saveSortedEntryCmd(s.client, sidekiq.ScheduledSet, s.detailEntry, msg)
+	case jobSavedMsg:
+		if s.handleJobSaved(msg) {
+			return s, s.fetchDataCmd()
+		}
+		s.updateTableRows()
+		return s, nil
+	}
+
 	// If showing detail, delegate to detail component
 	if s.showDetail {
 		switch msg := msg.(type) {
 		case tea.KeyMsg:
-			if msg.String() == "esc" {
+			if key.Matches(msg, s.jobDetail.KeyMap.Close) {
 				s.showDetail = false
 				return s, nil
 			}
+		case jobdetail.ActionMsg:
+			return s, editJobCmd(s.client, msg.Action, msg.Job)
 		}
-		s.jobDetail, _ = s.jobDetail.Update(msg)
-		return s, nil
+		var cmd tea.Cmd
+		s.jobDetail, cmd = s.jobDetail.Update(msg)
+		return s, cmd
 	}
 
+	defer s.syncDetail()
+
 	switch msg := msg.(type) {
 	case scheduledDataMsg:
 		s.jobs = msg.jobs
@@ -139,6 +183,9 @@ func (s *Scheduled) Update(msg tea.Msg) (View, tea.Cmd) {
 		s.totalSize = msg.totalSize
 		s.ready = true
 		s.updateTableRows()
+		if entry := s.pending.take(s.jobs); entry != nil {
+			s.openDetail(entry)
+		}
 		return s, nil
 
 	case RefreshMsg:
@@ -152,16 +199,26 @@ func (s *Scheduled) Update(msg tea.Msg) (View, tea.Cmd) {
 		}
 		return s, nil
 
+	case tea.MouseWheelMsg:
+		s.table, _ = s.table.Update(msg)
+		return s, nil
+
+	case tea.MouseClickMsg:
+		if msg.Button == tea.MouseLeft {
+			s.table.ClickRow(msg.Y - frameBorderHeight - filterHeight)
+		}
+		return s, nil
+
 	case tea.KeyMsg:
 		wasFocused := s.filter.Focused()
 		var cmd tea.Cmd
 		s.filter, cmd = s.filter.Update(msg)
-		if wasFocused || msg.String() == "/" || msg.String() == "esc" || msg.String() == "ctrl+u" {
+		if wasFocused || key.Matches(msg, s.filter.KeyMap.Focus, s.filter.KeyMap.Clear) {
 			return s, cmd
 		}
 
-		switch msg.String() {
-		case "alt+left", "[":
+		switch {
+		case key.Matches(msg, s.keys.PrevPage):
 			if s.filter.Query() != "" {
 				return s, nil
 			}
@@ -170,7 +227,7 @@ func (s *Scheduled) Update(msg tea.Msg) (View, tea.Cmd) {
 				return s, s.fetchDataCmd()
 			}
 			return s, nil
-		case "alt+right", "]":
+		case key.Matches(msg, s.keys.NextPage):
 			if s.filter.Query() != "" {
 				return s, nil
 			}
@@ -179,12 +236,8 @@ func (s *Scheduled) Update(msg tea.Msg) (View, tea.Cmd) {
 				return s, s.fetchDataCmd()
 			}
 			return s, nil
-		case "enter":
-			// Show detail for selected job
-			if idx := s.table.Cursor(); idx >= 0 && idx < len(s.jobs) {
-				s.jobDetail.SetJob(s.jobs[idx].JobRecord)
-				s.showDetail = true
-			}
+		case key.Matches(msg, s.keys.Open):
+			s.openSelected()
 			return s, nil
 		}
 
@@ -197,10 +250,11 @@ func (s *Scheduled) Update(msg tea.Msg) (View, tea.Cmd) {
 
 // View implements View.
 func (s *Scheduled) View() string {
-	if s.showDetail {
-		return s.renderJobDetail()
-	}
+	return s.view(s.styles, s.renderList)
+}
 
+// renderList renders the job list, or a message while it is loading or empty.
+func (s *Scheduled) renderList() string {
 	if !s.ready {
 		return s.renderMessage("Loading...")
 	}
@@ -217,7 +271,7 @@ func (s *Scheduled) renderMessage(msg string) string {
 		Title:  s.styles.Title,
 		Muted:  s.styles.Muted,
 		Border: s.styles.FocusBorder,
-	}, "Scheduled", msg, s.width, s.height)
+	}, "Scheduled", msg, s.listWidth(), s.height)
 }
 
 // Name implements View.
@@ -230,13 +284,17 @@ func (s *Scheduled) ShortHelp() []key.Binding {
 	return nil
 }
 
+// FullHelp implements View.
+func (s *Scheduled) FullHelp() []HelpSection {
+	if s.showDetail {
+		return jobDetailHelp(s.jobDetail)
+	}
+	return jobListHelp(s.keys, "Scheduled", s.filter, s.table)
+}
+
 // SetSize implements View.
 func (s *Scheduled) SetSize(width, height int) View {
-	s.width = width
-	s.height = height
-	s.updateTableSize()
-	// Update job detail size (full size, component handles its own borders)
-	s.jobDetail.SetSize(width, height)
+	s.setSize(width, height)
 	return s
 }
 
@@ -245,6 +303,24 @@ func (s *Scheduled) FilterFocused() bool {
 	return s.filter.Focused()
 }
 
+// SetColumns implements ColumnConfigurable.
+func (s *Scheduled) SetColumns(columns []config.Column) error {
+	if err := s.columns.configure(columns); err != nil {
+		return err
+	}
+	s.table.SetColumns(s.columns.tableColumns())
+	s.updateTableRows()
+	return nil
+}
+
+// SetKeyMaps implements KeyMapConfigurable.
+func (s *Scheduled) SetKeyMaps(keys KeyMaps) {
+	s.keys = keys.Jobs
+	s.table.KeyMap = keys.Table
+	s.filter.KeyMap = keys.Filter
+	s.jobDetail.KeyMap = keys.JobDetail
+}
+
 // SetStyles implements View.
 func (s *Scheduled) SetStyles(styles Styles) View {
 	s.styles = styles
@@ -276,27 +352,24 @@ func (s *Scheduled) SetStyles(styles Styles) View {
 		PanelTitle:      styles.Title,
 		FocusBorder:     styles.FocusBorder,
 		Muted:           styles.Muted,
+		Selected:        styles.TableSelected,
 	})
 	return s
 }
 
 // Table columns for scheduled job list.
-var scheduledJobColumns = []table.Column{
-	{Title: "When", Width: 12},
-	{Title: "Queue", Width: 15},
-	{Title: "Job", Width: 30},
-	{Title: "Arguments", Width: 60},
+var scheduledJobColumns = []jobColumn[*sidekiq.SortedEntry]{
+	{name: "when", title: "When", width: 12, value: func(job *sidekiq.SortedEntry) string {
+		// Format "when" as time until job runs (job.At() is in the future)
+		return format.Duration(job.At() - time.Now().Unix())
+	}},
+	{name: "args", title: "Arguments", width: 60, value: func(job *sidekiq.SortedEntry) string {
+		return format.Args(job.DisplayArgs())
+	}},
 }
 
-// updateTableSize updates the table dimensions based on current view size.
-func (s *Scheduled) updateTableSize() {
-	// Calculate table height: total height - box borders
-	tableHeight := max(s.height-3, 3)
-	// Table width: view width - box borders - padding
-	tableWidth := s.width - 4
-	s.table.SetSize(tableWidth, tableHeight)
-	s.filter.SetWidth(tableWidth)
-}
+// scheduledDefaultColumns lists the columns shown unless overridden in the config file.
+var scheduledDefaultColumns = []string{"when", "queue", "class", "args"}
 
 // updateTableRows converts job data to table rows.
 func (s *Scheduled) updateTableRows() {
@@ -307,21 +380,11 @@ func (s *Scheduled) updateTableRows() {
 	}
 
 	rows := make([]table.Row, 0, len(s.jobs))
-	now := time.Now().Unix()
 	for _, job := range s.jobs {
-		// Format "when" as time until job runs (job.At() is in the future)
-		when := format.Duration(job.At() - now)
-
-		row := table.Row{
-			when,
-			job.Queue(),
-			job.DisplayClass(),
-			format.Args(job.DisplayArgs()),
-		}
-		rows = append(rows, row)
+		rows = append(rows, s.columns.row(job))
 	}
 	s.table.SetRows(rows)
-	s.updateTableSize()
+	s.resize()
 }
 
 // renderJobsBox renders the bordered box containing the jobs table.
@@ -351,14 +414,9 @@ func (s *Scheduled) renderJobsBox() string {
 		frame.WithMeta(meta),
 		frame.WithContent(content),
 		frame.WithPadding(1),
-		frame.WithSize(s.width, s.height),
+		frame.WithSize(s.listWidth(), s.height),
 		frame.WithMinHeight(5),
-		frame.WithFocused(true),
+		frame.WithFocused(!s.showDetail),
 	)
 	return box.View()
 }
-
-// renderJobDetail renders the job detail view.
-func (s *Scheduled) renderJobDetail() string {
-	return s.jobDetail.View()
-}
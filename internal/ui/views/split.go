@@ -0,0 +1,268 @@
+package views
+
+import (
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+	"github.com/kpumuk/lazykiq/internal/sidekiq"
+	"github.com/kpumuk/lazykiq/internal/ui/clipboard"
+	"github.com/kpumuk/lazykiq/internal/ui/components/filterinput"
+	"github.com/kpumuk/lazykiq/internal/ui/components/jobdetail"
+	"github.com/kpumuk/lazykiq/internal/ui/components/messagebox"
+	"github.com/kpumuk/lazykiq/internal/ui/components/table"
+)
+
+// SplitLayout configures showing a job list and the job under the cursor
+// side by side.
+type SplitLayout struct {
+	Enabled  bool
+	MinWidth int
+}
+
+// SplitConfigurable is implemented by job list views supporting the split
+// layout.
+type SplitConfigurable interface {
+	SetSplitLayout(layout SplitLayout)
+}
+
+// active reports whether width is wide enough for the split layout.
+func (l SplitLayout) active(width int) bool {
+	return l.Enabled && width >= l.MinWidth
+}
+
+// widths returns the list and detail pane widths. Without the split layout
+// both panes take the full width.
+func (l SplitLayout) widths(width int) (int, int) {
+	if !l.active(width) {
+		return width, width
+	}
+	list := width * 2 / 5
+	return list, width - list
+}
+
+// splitMouseTarget reports whether msg is a mouse event and whether it falls
+// in the detail pane, returning it relative to that pane.
+func splitMouseTarget(msg tea.Msg, listWidth int) (tea.Msg, bool, bool) {
+	switch msg := msg.(type) {
+	case tea.MouseClickMsg:
+		if msg.X < listWidth {
+			return msg, true, false
+		}
+		msg.X -= listWidth
+		return msg, true, true
+	case tea.MouseWheelMsg:
+		if msg.X < listWidth {
+			return msg, true, false
+		}
+		msg.X -= listWidth
+		return msg, true, true
+	}
+	return msg, false, false
+}
+
+// splitList is embedded by the sorted set job lists. It shows the job
+// detail in place of the list, or beside it in the split layout where the
+// detail follows the cursor until it is focused.
+type splitList struct {
+	split  SplitLayout
+	width  int
+	height int
+
+	// The list of the embedding view
+	list    *table.Model
+	filter  *filterinput.Model
+	entries *[]*sidekiq.SortedEntry
+
+	// Job detail state
+	showDetail  bool
+	jobDetail   jobdetail.Model
+	detailEntry *sidekiq.SortedEntry
+}
+
+// newSplitList returns a split list showing the entries of list in detail.
+func newSplitList(list *table.Model, filter *filterinput.Model, entries *[]*sidekiq.SortedEntry, detail jobdetail.Model) splitList {
+	return splitList{
+		list:      list,
+		filter:    filter,
+		entries:   entries,
+		jobDetail: detail,
+	}
+}
+
+// SetSplitLayout implements SplitConfigurable.
+func (l *splitList) SetSplitLayout(layout SplitLayout) {
+	l.split = layout
+	l.setSize(l.width, l.height)
+	l.syncDetail()
+}
+
+// SetClipboardMode implements ClipboardConfigurable.
+func (l *splitList) SetClipboardMode(mode clipboard.Mode) {
+	l.jobDetail.SetClipboardMode(mode)
+}
+
+// setSize sizes the list and the job detail panes.
+func (l *splitList) setSize(width, height int) {
+	l.width = width
+	l.height = height
+	l.resize()
+}
+
+// resize fits the table and filter in the list pane, inside its box
+// borders and padding, and the job detail in its pane.
+func (l *splitList) resize() {
+	tableWidth := l.listWidth() - 4
+	l.list.SetSize(tableWidth, max(l.height-3, 3))
+	l.filter.SetWidth(tableWidth)
+	// The job detail component handles its own borders
+	_, detailWidth := l.split.widths(l.width)
+	l.jobDetail.SetSize(detailWidth, l.height)
+}
+
+// handleJobSaved updates the edited job in the list, and in the job detail
+// while it still shows the job. It reports whether the job left the list,
+// which needs fetching again.
+func (l *splitList) handleJobSaved(msg jobSavedMsg) bool {
+	shown := l.detailEntry != nil && isEditedJob(l.detailEntry.JobRecord, msg.original)
+	if msg.err != nil {
+		if shown {
+			l.jobDetail.SetStatus(editStatus(msg.action, msg.err))
+		}
+		return false
+	}
+	if msg.action == jobdetail.ActionEditEnqueue {
+		if shown {
+			l.showDetail = false
+			l.detailEntry = nil
+		}
+		return true
+	}
+	var updated *sidekiq.SortedEntry
+	if shown {
+		updated = sidekiq.NewSortedEntry(msg.job.Value(), l.detailEntry.Score)
+	}
+	for i, entry := range *l.entries {
+		switch {
+		case shown && entry == l.detailEntry:
+			(*l.entries)[i] = updated
+		case isEditedJob(entry.JobRecord, msg.original):
+			(*l.entries)[i] = sidekiq.NewSortedEntry(msg.job.Value(), entry.Score)
+		}
+	}
+	if !shown {
+		return false
+	}
+	l.detailEntry = updated
+	l.jobDetail.SetJob(updated.JobRecord)
+	l.jobDetail.SetStatus(editStatus(msg.action, nil))
+	return false
+}
+
+// listWidth returns the width of the job list.
+func (l *splitList) listWidth() int {
+	width, _ := l.split.widths(l.width)
+	return width
+}
+
+// selectedEntry returns the entry under the cursor, or nil.
+func (l *splitList) selectedEntry() *sidekiq.SortedEntry {
+	if idx := l.list.Cursor(); idx >= 0 && idx < len(*l.entries) {
+		return (*l.entries)[idx]
+	}
+	return nil
+}
+
+// openDetail shows entry in the focused job detail.
+func (l *splitList) openDetail(entry *sidekiq.SortedEntry) {
+	l.detailEntry = entry
+	l.jobDetail.SetJob(entry.JobRecord)
+	l.showDetail = true
+}
+
+// openSelected focuses the job under the cursor. The split layout already
+// shows it, so only the focus moves there.
+func (l *splitList) openSelected() {
+	if l.split.active(l.width) {
+		l.showDetail = l.detailEntry != nil
+		return
+	}
+	if entry := l.selectedEntry(); entry != nil {
+		l.openDetail(entry)
+	}
+}
+
+// updateSplitMouse routes mouse events in the split layout: events over the
+// list blur the detail, events over the detail focus it and are handled
+// there. It reports whether msg was handled.
+func (l *splitList) updateSplitMouse(msg tea.Msg) (tea.Cmd, bool) {
+	if !l.split.active(l.width) {
+		return nil, false
+	}
+	detailMsg, mouse, inDetail := splitMouseTarget(msg, l.listWidth())
+	if !mouse {
+		return nil, false
+	}
+	if !inDetail {
+		l.showDetail = false
+		return nil, false
+	}
+	if l.detailEntry == nil {
+		return nil, false
+	}
+	l.showDetail = true
+	var cmd tea.Cmd
+	l.jobDetail, cmd = l.jobDetail.Update(detailMsg)
+	return cmd, true
+}
+
+// syncDetail points the split layout detail at the entry under the cursor.
+// The detail keeps its scroll position when a refresh returns the same
+// payload.
+func (l *splitList) syncDetail() {
+	if l.showDetail || !l.split.active(l.width) {
+		return
+	}
+	previous := l.detailEntry
+	entry := l.selectedEntry()
+	l.detailEntry = entry
+	switch {
+	case entry == nil:
+		if previous != nil {
+			l.jobDetail.SetJob(nil)
+		}
+	case previous == nil || previous.Value() != entry.Value():
+		l.jobDetail.SetJob(entry.JobRecord)
+	}
+}
+
+// view renders the list beside the job detail in the split layout, or the
+// job detail in place of the list while it is shown.
+func (l *splitList) view(styles Styles, list func() string) string {
+	if l.split.active(l.width) {
+		_, detailWidth := l.split.widths(l.width)
+		return lipgloss.JoinHorizontal(
+			lipgloss.Top,
+			list(),
+			l.renderSplitDetail(styles, detailWidth),
+		)
+	}
+	if l.showDetail {
+		return l.jobDetail.View()
+	}
+	return list()
+}
+
+// renderSplitDetail renders the detail pane of the split layout.
+func (l *splitList) renderSplitDetail(styles Styles, width int) string {
+	if l.jobDetail.Job() == nil {
+		return messagebox.Render(messagebox.Styles{
+			Title:  styles.Title,
+			Muted:  styles.Muted,
+			Border: styles.BorderStyle,
+		}, "Job", "No job selected", width, l.height)
+	}
+	detail := l.jobDetail
+	if !l.showDetail {
+		detail.Blur()
+	}
+	return detail.View()
+}
@@ -0,0 +1,81 @@
+package views
+
+import (
+	"testing"
+
+	"github.com/kpumuk/lazykiq/internal/sidekiq"
+	"github.com/kpumuk/lazykiq/internal/ui/components/jobdetail"
+)
+
+func TestSplitListHandleJobSaved(t *testing.T) {
+	const (
+		original = `{"class":"MyJob","args":[1],"jid":"a"}`
+		edited   = `{"class":"MyJob","args":[2],"jid":"a"}`
+		other    = `{"class":"MyJob","args":[1],"jid":"b"}`
+	)
+
+	tests := []struct {
+		name       string
+		detail     string
+		wantDetail string
+	}{
+		{name: "detail-shows-job", detail: original, wantDetail: edited},
+		{name: "detail-moved", detail: other, wantDetail: other},
+		{name: "detail-closed"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := NewRetries(nil)
+			// The list was refreshed while saving, so entries are new values
+			r.jobs = []*sidekiq.SortedEntry{
+				sidekiq.NewSortedEntry(other, 1),
+				sidekiq.NewSortedEntry(original, 2),
+			}
+			if tt.detail != "" {
+				r.openDetail(sidekiq.NewSortedEntry(tt.detail, 3))
+			}
+
+			r.Update(jobSavedMsg{
+				action:   jobdetail.ActionEdit,
+				original: sidekiq.NewJobRecord(original, ""),
+				job:      sidekiq.NewJobRecord(edited, ""),
+			})
+
+			if got := r.jobs[1]; got.Value() != edited || got.Score != 2 {
+				t.Fatalf("jobs[1] = %s (score %v), want %s (score 2)", got.Value(), got.Score, edited)
+			}
+			if got := r.jobs[0].Value(); got != other {
+				t.Fatalf("jobs[0] = %s, want %s", got, other)
+			}
+			var detail string
+			if r.detailEntry != nil {
+				detail = r.detailEntry.Value()
+			}
+			if detail != tt.wantDetail {
+				t.Fatalf("detail = %q, want %q", detail, tt.wantDetail)
+			}
+		})
+	}
+}
+
+func TestSplitListRenderTickWhileDetailOpen(t *testing.T) {
+	r := NewRetries(nil)
+	r.jobs = []*sidekiq.SortedEntry{
+		sidekiq.NewSortedEntry(`{"class":"MyJob","args":[],"jid":"a"}`, 1),
+		sidekiq.NewSortedEntry(`{"class":"MyJob","args":[],"jid":"b"}`, 2),
+	}
+	r.openDetail(r.jobs[0])
+
+	_, cmd := r.Update(RenderTickMsg{})
+
+	if cmd != nil {
+		t.Fatal("Update(RenderTickMsg) returned a command, want rows re-rendered without fetching")
+	}
+	if got := r.table.RowCount(); got != 2 {
+		t.Fatalf("RowCount() = %d, want 2 rows rebuilt behind the detail", got)
+	}
+	if !r.showDetail {
+		t.Fatal("render tick closed the job detail")
+	}
+}
@@ -0,0 +1,204 @@
+package views
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"charm.land/lipgloss/v2"
+	"github.com/kpumuk/lazykiq/internal/sidekiq"
+	"github.com/kpumuk/lazykiq/internal/ui/components/frame"
+	"github.com/kpumuk/lazykiq/internal/ui/components/table"
+	"github.com/kpumuk/lazykiq/internal/ui/format"
+)
+
+// hostBarWidth is the width of the utilization bar of each host.
+const hostBarWidth = 20
+
+var busySummaryColumns = []table.Column{
+	{Title: "Class", Width: 30},
+	{Title: "Queue", Width: 12},
+	{Title: "Jobs", Width: 5},
+	{Title: "Max", Width: 8},
+	{Title: "Avg", Width: 8},
+}
+
+// jobGroup aggregates running jobs of a class on a queue.
+type jobGroup struct {
+	class        string
+	queue        string
+	count        int
+	maxElapsed   int64
+	totalElapsed int64
+}
+
+// avgElapsed returns the average runtime of the group in seconds.
+func (g jobGroup) avgElapsed() int64 {
+	if g.count == 0 {
+		return 0
+	}
+	return g.totalElapsed / int64(g.count)
+}
+
+// hostUsage is the thread utilization of all processes on a host.
+type hostUsage struct {
+	host        string
+	processes   int
+	busy        int
+	concurrency int
+}
+
+// summarizeJobs groups running jobs by class and queue, busiest first.
+func summarizeJobs(jobs []sidekiq.Job, now time.Time) []jobGroup {
+	index := make(map[[2]string]int)
+	var groups []jobGroup
+	for _, job := range jobs {
+		if job.JobRecord == nil {
+			continue
+		}
+		groupKey := [2]string{job.DisplayClass(), job.Queue()}
+		i, ok := index[groupKey]
+		if !ok {
+			i = len(groups)
+			index[groupKey] = i
+			groups = append(groups, jobGroup{class: groupKey[0], queue: groupKey[1]})
+		}
+		elapsed := max(now.Unix()-job.RunAt, 0)
+		groups[i].count++
+		groups[i].totalElapsed += elapsed
+		groups[i].maxElapsed = max(groups[i].maxElapsed, elapsed)
+	}
+	slices.SortFunc(groups, func(a, b jobGroup) int {
+		if c := cmp.Compare(b.count, a.count); c != 0 {
+			return c
+		}
+		if c := cmp.Compare(b.maxElapsed, a.maxElapsed); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.class+a.queue, b.class+b.queue)
+	})
+	return groups
+}
+
+// hostUtilization sums busy threads and concurrency per host.
+func hostUtilization(processes []sidekiq.Process) []hostUsage {
+	index := make(map[string]int)
+	var hosts []hostUsage
+	for _, proc := range processes {
+		i, ok := index[proc.Hostname]
+		if !ok {
+			i = len(hosts)
+			index[proc.Hostname] = i
+			hosts = append(hosts, hostUsage{host: proc.Hostname})
+		}
+		hosts[i].processes++
+		hosts[i].busy += proc.Busy
+		hosts[i].concurrency += proc.Concurrency
+	}
+	slices.SortFunc(hosts, func(a, b hostUsage) int {
+		return cmp.Compare(a.host, b.host)
+	})
+	return hosts
+}
+
+// updateSummaryRows fills the summary table from the listed jobs.
+func (b *Busy) updateSummaryRows() {
+	groups := summarizeJobs(b.filteredJobs, time.Now())
+	rows := make([]table.Row, len(groups))
+	for i, group := range groups {
+		rows[i] = table.Row{
+			group.class,
+			group.queue,
+			format.Number(int64(group.count)),
+			format.Duration(group.maxElapsed),
+			format.Duration(group.avgElapsed()),
+		}
+	}
+	b.summaryTable.SetRows(rows)
+}
+
+// hostLines renders a utilization bar per host.
+func (b *Busy) hostLines() []string {
+	hosts := hostUtilization(b.data.Processes)
+	if len(hosts) == 0 {
+		return nil
+	}
+
+	nameWidth, usageWidth := 0, 0
+	percentages := make([]int, len(hosts))
+	usages := make([]string, len(hosts))
+	for i, host := range hosts {
+		if host.concurrency > 0 {
+			percentages[i] = host.busy * 100 / host.concurrency
+		}
+		usages[i] = fmt.Sprintf("%d/%d (%d%%)", host.busy, host.concurrency, percentages[i])
+		nameWidth = max(nameWidth, lipgloss.Width(host.host))
+		usageWidth = max(usageWidth, len(usages[i]))
+	}
+	lines := make([]string, len(hosts))
+	for i, host := range hosts {
+		percentage := percentages[i]
+		filled := min(percentage*hostBarWidth/100, hostBarWidth)
+		bar := b.styles.ChartSuccess.Render(strings.Repeat("█", filled)) +
+			b.styles.Muted.Render(strings.Repeat("░", hostBarWidth-filled))
+		processes := "processes"
+		if host.processes == 1 {
+			processes = "process"
+		}
+		lines[i] = b.styles.Text.Render(fmt.Sprintf("%-*s", nameWidth, host.host)) + "  " + bar + "  " +
+			b.styles.MetricValue.Render(fmt.Sprintf("%-*s", usageWidth, usages[i])) +
+			b.styles.Muted.Render(fmt.Sprintf("  %d %s", host.processes, processes))
+	}
+	return lines
+}
+
+// summaryHeaderHeight returns the height of the host lines and the blank
+// line separating them from the summary table.
+func (b *Busy) summaryHeaderHeight() int {
+	hosts := len(hostUtilization(b.data.Processes))
+	if hosts == 0 {
+		return 0
+	}
+	return hosts + 1
+}
+
+// renderSummaryBox renders host utilization above running jobs grouped by
+// class and queue.
+func (b *Busy) renderSummaryBox() string {
+	content := b.summaryTable.View()
+	if lines := b.hostLines(); len(lines) > 0 {
+		hosts := lipgloss.NewStyle().MaxWidth(max(b.width-4, 1)).Render(strings.Join(lines, "\n"))
+		content = hosts + "\n\n" + content
+	}
+
+	title := "Running by Class"
+	if proc := b.selectedProcessInfo(); proc != nil {
+		title = fmt.Sprintf("Running by Class on %s:%s", proc.Hostname, proc.PID)
+	}
+	meta := b.styles.MetricLabel.Render("jobs: ") + b.styles.MetricValue.Render(format.Number(int64(len(b.filteredJobs)))) +
+		b.styles.Muted.Render(" • ") +
+		b.styles.MetricLabel.Render("groups: ") + b.styles.MetricValue.Render(format.Number(int64(b.summaryTable.RowCount())))
+
+	return frame.New(
+		frame.WithStyles(frame.Styles{
+			Focused: frame.StyleState{
+				Title:  b.styles.Title,
+				Border: b.styles.FocusBorder,
+			},
+			Blurred: frame.StyleState{
+				Title:  b.styles.Title,
+				Border: b.styles.BorderStyle,
+			},
+		}),
+		frame.WithTitle(title),
+		frame.WithTitlePadding(0),
+		frame.WithMeta(meta),
+		frame.WithContent(content),
+		frame.WithPadding(1),
+		frame.WithSize(b.width, b.height-len(b.data.Processes)-b.processInfoHeight()),
+		frame.WithMinHeight(5),
+		frame.WithFocused(true),
+	).View()
+}
@@ -0,0 +1,205 @@
+package views
+
+import (
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kpumuk/lazykiq/internal/sidekiq"
+	"github.com/kpumuk/lazykiq/internal/ui/format"
+)
+
+// busyJob returns a job of class on queue started elapsed seconds before now.
+func busyJob(class, queue string, now time.Time, elapsed int64) sidekiq.Job {
+	return sidekiq.Job{
+		JobRecord: sidekiq.NewJobRecord(`{"class":"`+class+`","queue":"`+queue+`"}`, ""),
+		RunAt:     now.Unix() - elapsed,
+	}
+}
+
+func TestSummarizeJobs(t *testing.T) {
+	now := time.Unix(1_700_000_000, 0)
+
+	tests := []struct {
+		name string
+		jobs []sidekiq.Job
+		want []jobGroup
+	}{
+		{name: "empty"},
+		{
+			name: "groups-by-class-and-queue",
+			jobs: []sidekiq.Job{
+				busyJob("A", "default", now, 10),
+				busyJob("A", "critical", now, 20),
+				busyJob("A", "default", now, 30),
+			},
+			want: []jobGroup{
+				{class: "A", queue: "default", count: 2, maxElapsed: 30, totalElapsed: 40},
+				{class: "A", queue: "critical", count: 1, maxElapsed: 20, totalElapsed: 20},
+			},
+		},
+		{
+			name: "orders-by-count-max-elapsed-and-name",
+			jobs: []sidekiq.Job{
+				busyJob("C", "default", now, 5),
+				busyJob("B", "default", now, 5),
+				busyJob("D", "default", now, 50),
+				busyJob("E", "default", now, 1),
+				busyJob("E", "default", now, 1),
+			},
+			want: []jobGroup{
+				{class: "E", queue: "default", count: 2, maxElapsed: 1, totalElapsed: 2},
+				{class: "D", queue: "default", count: 1, maxElapsed: 50, totalElapsed: 50},
+				{class: "B", queue: "default", count: 1, maxElapsed: 5, totalElapsed: 5},
+				{class: "C", queue: "default", count: 1, maxElapsed: 5, totalElapsed: 5},
+			},
+		},
+		{
+			name: "skips-missing-payloads-and-clamps-future-starts",
+			jobs: []sidekiq.Job{
+				{RunAt: now.Unix() - 100},
+				busyJob("A", "default", now, -30),
+			},
+			want: []jobGroup{
+				{class: "A", queue: "default", count: 1},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := summarizeJobs(tt.jobs, now); !slices.Equal(got, tt.want) {
+				t.Fatalf("summarizeJobs() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestJobGroupAvgElapsed(t *testing.T) {
+	tests := []struct {
+		name  string
+		group jobGroup
+		want  int64
+	}{
+		{name: "empty", group: jobGroup{}, want: 0},
+		{name: "single", group: jobGroup{count: 1, totalElapsed: 42}, want: 42},
+		{name: "truncates", group: jobGroup{count: 3, totalElapsed: 100}, want: 33},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.group.avgElapsed(); got != tt.want {
+				t.Fatalf("avgElapsed() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHostUtilization(t *testing.T) {
+	tests := []struct {
+		name      string
+		processes []sidekiq.Process
+		want      []hostUsage
+	}{
+		{name: "empty"},
+		{
+			name: "sums-processes-per-host",
+			processes: []sidekiq.Process{
+				{Hostname: "web-2", Busy: 1, Concurrency: 5},
+				{Hostname: "web-1", Busy: 3, Concurrency: 10},
+				{Hostname: "web-2", Busy: 4, Concurrency: 5},
+			},
+			want: []hostUsage{
+				{host: "web-1", processes: 1, busy: 3, concurrency: 10},
+				{host: "web-2", processes: 2, busy: 5, concurrency: 10},
+			},
+		},
+		{
+			name: "zero-concurrency",
+			processes: []sidekiq.Process{
+				{Hostname: "idle"},
+			},
+			want: []hostUsage{
+				{host: "idle", processes: 1},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := hostUtilization(tt.processes); !slices.Equal(got, tt.want) {
+				t.Fatalf("hostUtilization() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBusyHostLines(t *testing.T) {
+	tests := []struct {
+		name      string
+		processes []sidekiq.Process
+		want      []string
+	}{
+		{name: "no-processes"},
+		{
+			name: "usage",
+			processes: []sidekiq.Process{
+				{Hostname: "web-1", Busy: 3, Concurrency: 4},
+				{Hostname: "web-1", Busy: 0, Concurrency: 4},
+			},
+			want: []string{"3/8 (37%)", "2 processes"},
+		},
+		{
+			name:      "zero-concurrency",
+			processes: []sidekiq.Process{{Hostname: "idle"}},
+			want:      []string{"0/0 (0%)", "1 process"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := NewBusy(nil)
+			b.data.Processes = tt.processes
+			lines := b.hostLines()
+			if len(tt.want) == 0 {
+				if lines != nil {
+					t.Fatalf("hostLines() = %q, want nil", lines)
+				}
+				return
+			}
+			if len(lines) != 1 {
+				t.Fatalf("hostLines() = %q, want 1 line", lines)
+			}
+			for _, want := range tt.want {
+				if !strings.Contains(lines[0], want) {
+					t.Fatalf("hostLines() = %q, want containing %q", lines[0], want)
+				}
+			}
+		})
+	}
+}
+
+func TestBusyRenderTickUpdatesElapsed(t *testing.T) {
+	b := NewBusy(nil)
+	runAt := time.Now().Unix() - 90
+	b.data.Jobs = []sidekiq.Job{{
+		JobRecord: sidekiq.NewJobRecord(`{"class":"A","queue":"default"}`, ""),
+		RunAt:     runAt,
+	}}
+
+	before := time.Now().Unix() - runAt
+	_, cmd := b.Update(RenderTickMsg{})
+	after := time.Now().Unix() - runAt
+
+	if cmd != nil {
+		t.Fatal("Update(RenderTickMsg) returned a command, want rows re-rendered without fetching")
+	}
+	rows := b.summaryTable.Rows()
+	if len(rows) != 1 {
+		t.Fatalf("summary rows = %v, want 1 row", rows)
+	}
+	if got := rows[0][3]; got != format.Duration(before) && got != format.Duration(after) {
+		t.Fatalf("max elapsed = %q, want %q", got, format.Duration(before))
+	}
+}
@@ -5,6 +5,11 @@ import (
 	"charm.land/bubbles/v2/key"
 	tea "charm.land/bubbletea/v2"
 	"charm.land/lipgloss/v2"
+	"github.com/kpumuk/lazykiq/internal/history"
+	"github.com/kpumuk/lazykiq/internal/sidekiq"
+	"github.com/kpumuk/lazykiq/internal/ui/clipboard"
+	"github.com/kpumuk/lazykiq/internal/ui/components/filterinput"
+	"github.com/kpumuk/lazykiq/internal/ui/components/table"
 )
 
 // Styles holds the view-related styles from the theme.
@@ -31,16 +36,120 @@ type Styles struct {
 	JSONPunctuation lipgloss.Style
 }
 
+// KeyMapConfigurable is implemented by views whose key bindings, or those
+// of the components they embed, can be changed from the config file.
+type KeyMapConfigurable interface {
+	SetKeyMaps(keys KeyMaps)
+}
+
+// HistoryConfigurable is implemented by views recording realtime samples
+// across runs.
+type HistoryConfigurable interface {
+	SetHistory(store *history.Store)
+}
+
+// ClipboardConfigurable is implemented by views copying job details.
+type ClipboardConfigurable interface {
+	SetClipboardMode(mode clipboard.Mode)
+}
+
+// ThresholdConfigurable is implemented by views highlighting jobs that run
+// longer than a threshold.
+type ThresholdConfigurable interface {
+	SetRuntimeThresholds(thresholds RuntimeThresholds)
+}
+
+// Layout offsets used to map mouse coordinates onto framed tables.
+const (
+	// frameBorderHeight is the height of a frame's top border (with title).
+	frameBorderHeight = 1
+	// filterHeight is the height of the filter input above job tables.
+	filterHeight = 1
+)
+
 // RefreshMsg is broadcast by the app on the 5-second ticker.
 // Views should respond by fetching their data.
 type RefreshMsg struct{}
 
+// RenderTickMsg is broadcast by the app every second. Views showing relative
+// times should re-render them from the last fetched data, without fetching.
+type RenderTickMsg struct{}
+
 // ConnectionErrorMsg indicates a Redis connection error occurred.
 // Views emit this when data fetching fails.
 type ConnectionErrorMsg struct {
 	Err error
 }
 
+// ClientMsg carries the message of a command run against a Sidekiq client.
+// The app drops it once a context switch replaced the client, so results
+// and ticks of the previous context never reach the views of the next one.
+type ClientMsg struct {
+	Client *sidekiq.Client
+	Msg    tea.Msg
+}
+
+// ClientCmd tags the message of cmd with the client it runs against.
+func ClientCmd(client *sidekiq.Client, cmd tea.Cmd) tea.Cmd {
+	return func() tea.Msg {
+		msg := cmd()
+		if msg == nil {
+			return nil
+		}
+		return ClientMsg{Client: client, Msg: msg}
+	}
+}
+
+// SelectQueueMsg asks the Queues view to select the named queue.
+type SelectQueueMsg struct {
+	Name string
+}
+
+// ShowJobMsg asks a job set view to filter by JID and open the job details.
+type ShowJobMsg struct {
+	JID string
+}
+
+// ShowBatchMsg asks the Batches view to open a batch.
+type ShowBatchMsg struct {
+	BID string
+}
+
+// FindJobMsg asks the app to look up a job by JID in the retry, scheduled,
+// and dead sets and show it.
+type FindJobMsg struct {
+	JID string
+}
+
+// pendingJob is a job requested with ShowJobMsg. Its list is filtered by
+// the JID and the job is opened once the filtered list loads.
+type pendingJob struct {
+	jid string
+}
+
+// request filters the list by jid so the next fetch finds the job.
+func (p *pendingJob) request(jid string, filter *filterinput.Model, list *table.Model) {
+	p.jid = jid
+	filter.SetQuery(jid)
+	list.SetCursor(0)
+}
+
+// take returns the requested job if entries hold it, or nil, and forgets
+// the request either way.
+func (p *pendingJob) take(entries []*sidekiq.SortedEntry) *sidekiq.SortedEntry {
+	jid := p.jid
+	p.jid = ""
+	if jid == "" {
+		return nil
+	}
+	for _, entry := range entries {
+		if entry.JID() == jid {
+			return entry
+		}
+	}
+	return nil
+}
+
 // View defines the interface that all views must implement.
 type View interface {
 	// Init returns an initial command for the view
@@ -58,6 +167,9 @@ type View interface {
 	// ShortHelp returns keybindings to show in the help view
 	ShortHelp() []key.Binding
 
+	// FullHelp returns grouped keybindings for the current mode of the view
+	FullHelp() []HelpSection
+
 	// SetSize updates the view dimensions
 	SetSize(width, height int) View
 